@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"knative.dev/client/pkg/config"
+	knerrors "knative.dev/client/pkg/errors"
 	pluginpkg "knative.dev/client/pkg/plugin"
 	"knative.dev/client/pkg/root"
 )
@@ -44,6 +45,13 @@ func (e *runError) Error() string {
 
 func runWithExit(args []string) int {
 	if err := run(args); err != nil {
+		var exitCodeError *knerrors.ExitCodeError
+		if errors.As(err, &exitCodeError) {
+			if exitCodeError.Error() != "" {
+				fmt.Fprintf(os.Stderr, "Error: %s\n", cleanupErrorMessage(exitCodeError.Error()))
+			}
+			return exitCodeError.ExitCode()
+		}
 		printError(err)
 		return 1
 	}
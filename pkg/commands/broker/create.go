@@ -69,12 +69,12 @@ func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -122,6 +122,7 @@ func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	cmd.Flags().StringVar(&className, "class", "", "Broker class like 'MTChannelBasedBroker' or 'Kafka' (if available).")
 	configFlags.Add(cmd)
 	deliveryFlags.Add(cmd)
@@ -53,7 +53,7 @@ func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -73,6 +73,7 @@ func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	waitFlags.AddConditionWaitFlags(cmd, commands.WaitDefaultTimeout, "delete", "broker", "deleted")
 	return cmd
 }
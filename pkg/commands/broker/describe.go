@@ -67,7 +67,7 @@ func NewBrokerDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -94,6 +94,7 @@ func NewBrokerDescribeCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	machineReadablePrintFlags.AddFlags(cmd)
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	return cmd
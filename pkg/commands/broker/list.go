@@ -37,7 +37,10 @@ var listExample = `
   kn broker list
 
   # List all brokers in JSON output format
-  kn broker list -o json`
+  kn broker list -o json
+
+  # List all brokers and watch for changes, e.g. to see them becoming ready
+  kn broker list --watch`
 
 // NewBrokerListCommand represents command to list all brokers
 func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
@@ -54,7 +57,7 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -65,7 +68,9 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 			}
 			if !brokerListFlags.GenericPrintFlags.OutputFlagSpecified() && len(brokerList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No brokers found.\n")
-				return nil
+				if !brokerListFlags.Watch {
+					return nil
+				}
 			}
 
 			// empty namespace indicates all-namespaces flag is specified
@@ -73,6 +78,9 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 				brokerListFlags.EnsureWithNamespace()
 			}
 
+			if brokerListFlags.Watch {
+				return brokerListFlags.PrintWatch(cmd.Context(), brokerList, eventingClient.WatchBrokers, cmd.OutOrStdout())
+			}
 			err = brokerListFlags.Print(brokerList, cmd.OutOrStdout())
 			if err != nil {
 				return err
@@ -81,7 +89,9 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
+	commands.AddGitOpsFlags(cmd.Flags())
 	brokerListFlags.AddFlags(cmd)
+	brokerListFlags.AddWatchFlag(cmd)
 	return cmd
 }
 
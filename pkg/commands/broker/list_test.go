@@ -24,6 +24,8 @@ import (
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 
 	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
 
 	clientv1 "knative.dev/client/pkg/eventing/v1"
 	"knative.dev/client/pkg/util"
@@ -115,3 +117,24 @@ func TestTriggerListAllNamespace(t *testing.T) {
 
 	eventingRecorder.Validate()
 }
+
+func TestBrokerListWatch(t *testing.T) {
+	eventingClient := clientv1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	watcher := watch.NewFakeWithChanSize(2, false)
+	watcher.Add(createBrokerWithGvk("foo1"))
+	watcher.Error(&apierrors.NewForbidden(eventingv1.Resource("brokers"), "", nil).ErrStatus)
+	eventingRecorder.ListBrokers(&eventingv1.BrokerList{}, nil)
+	eventingRecorder.WatchBrokers("", watcher, nil)
+
+	output, err := executeBrokerCommand(eventingClient, "list", "--watch")
+	assert.Assert(t, apierrors.IsForbidden(err))
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], "No", "brokers", "found"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "NAME", "URL", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "foo1"))
+
+	eventingRecorder.Validate()
+}
@@ -55,12 +55,12 @@ func NewBrokerUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -102,6 +102,7 @@ func NewBrokerUpdateCommand(p *commands.KnParams) *cobra.Command {
 			return preCheck(cmd)
 		}}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	deliveryFlags.Add(cmd)
 	return cmd
 }
@@ -46,6 +46,14 @@ func newChannelClient(p *commands.KnParams, cmd *cobra.Command) (messagingv1.KnC
 		return nil, err
 	}
 
+	if dir := commands.GitOpsTarget(cmd); dir != "" {
+		client, err := p.NewGitopsMessagingClient(namespace, dir)
+		if err != nil {
+			return nil, err
+		}
+		return client.ChannelsClient(), nil
+	}
+
 	if channelClientFactory != nil {
 		config, err := p.GetClientConfig()
 		if err != nil {
@@ -80,6 +80,7 @@ func NewChannelCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	ctypeFlags.Add(cmd.Flags())
 	return cmd
 }
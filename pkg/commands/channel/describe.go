@@ -101,6 +101,7 @@ func NewChannelDescribeCommand(p *commands.KnParams) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
 	machineReadablePrintFlags.AddFlags(cmd)
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
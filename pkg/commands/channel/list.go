@@ -79,6 +79,7 @@ func NewChannelListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
+	commands.AddGitOpsFlags(listCommand.Flags())
 	listFlags.AddFlags(listCommand)
 	return listCommand
 }
@@ -72,14 +72,6 @@ func (config *completionConfig) getCompletion(parent string) []string {
 	return completionFunc(config)
 }
 
-func getTargetFlagValue(cmd *cobra.Command) string {
-	flag := cmd.Flag("target")
-	if flag == nil {
-		return ""
-	}
-	return flag.Value.String()
-}
-
 func completeGitOps(config *completionConfig) (suggestions []string) {
 	suggestions = make([]string, 0)
 	if len(config.args) != 0 {
@@ -89,7 +81,7 @@ func completeGitOps(config *completionConfig) (suggestions []string) {
 	if err != nil {
 		return
 	}
-	client, err := config.params.NewGitopsServingClient(namespace, getTargetFlagValue(config.command))
+	client, err := config.params.NewGitopsServingClient(namespace, GitOpsTarget(config.command))
 	if err != nil {
 		return
 	}
@@ -107,7 +99,7 @@ func completeGitOps(config *completionConfig) (suggestions []string) {
 }
 
 func completeService(config *completionConfig) (suggestions []string) {
-	if getTargetFlagValue(config.command) != "" {
+	if GitOpsTarget(config.command) != "" {
 		return completeGitOps(config)
 	}
 
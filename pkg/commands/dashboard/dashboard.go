@@ -0,0 +1,125 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/commands/revision"
+	"knative.dev/client/pkg/commands/service"
+	"knative.dev/client/pkg/flags"
+	"knative.dev/client/pkg/output"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/output/tui"
+)
+
+var dashboardExample = `
+  # Show the dashboard of the services in the current namespace
+  kn dashboard
+
+  # Show the dashboard of the services in namespace 'dev', refreshed every 5 seconds
+  kn dashboard -n dev --refresh 5s`
+
+// NewDashboardCommand creates the command for the interactive dashboard of services
+func NewDashboardCommand(p *commands.KnParams) *cobra.Command {
+	var refresh time.Duration
+
+	command := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Show an interactive dashboard of services",
+		Long: `Show an interactive dashboard of services
+
+The dashboard lists the services with their readiness, URL, latest revision and
+traffic split, and shows the revisions, routes and conditions of a selected
+service. The traffic of a service can be updated, revisions can be tagged or
+deleted and their logs can be shown, just like with the corresponding kn commands.
+
+When not running in a terminal, the list of services is printed once.`,
+		Example: dashboardExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return errors.New("'kn dashboard' accepts no arguments")
+			}
+			if refresh <= 0 {
+				return fmt.Errorf("--refresh must be positive, not %s", refresh)
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			m := newModel(cmd.Context(), client, newRunner(p), namespace, refresh)
+			ctx := output.WithContext(cmd.Context(), output.NewPrinter(cmd))
+			iw, err := tui.NewInteractiveWidgets(ctx)
+			if err != nil || !term.IsWriterTerminal(cmd.OutOrStdout()) {
+				return printSnapshot(m, cmd)
+			}
+			_, err = iw.NewProgram(m).Run()
+			if errors.Is(err, tea.ErrProgramKilled) && cmd.Context().Err() != nil {
+				return nil
+			}
+			return err
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().DurationVar(&refresh, "refresh", 2*time.Second, "Interval for refreshing the shown resources.")
+	return command
+}
+
+// printSnapshot prints the services once, for a dashboard not running in a terminal
+func printSnapshot(m *model, cmd *cobra.Command) error {
+	msg, _ := m.fetch()().(refreshMsg)
+	if msg.err != nil {
+		return msg.err
+	}
+	m.applyRefresh(msg)
+	fmt.Fprint(cmd.OutOrStdout(), m.snapshot())
+	return nil
+}
+
+// newRunner returns a runFunc which runs the kn service and revision commands in-process,
+// so that the actions of the dashboard behave exactly like the commands
+func newRunner(p *commands.KnParams) runFunc {
+	return func(ctx context.Context, args ...string) (string, error) {
+		out := &bytes.Buffer{}
+		root := &cobra.Command{
+			Use:           "kn",
+			SilenceUsage:  true,
+			SilenceErrors: true,
+			PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+				return flags.ReconcileBoolFlags(cmd.Flags())
+			},
+		}
+		root.AddCommand(service.NewServiceCommand(p), revision.NewRevisionCommand(p))
+		root.SetArgs(args)
+		root.SetIn(&bytes.Buffer{})
+		root.SetOut(out)
+		root.SetErr(out)
+		err := root.ExecuteContext(ctx)
+		return out.String(), err
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDashboardSnapshot(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewDashboardCommand(knParams), knParams)
+	fakeServing.AddReactor("list", "services", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		return true, &servingv1.ServiceList{Items: []servingv1.Service{*newService("foo", "foo-00002")}}, nil
+	})
+	cmd.SetArgs([]string{"dashboard"})
+	assert.NilError(t, cmd.Execute())
+
+	lines := strings.Split(buf.String(), "\n")
+	assert.Equal(t, lines[0], "Services in namespace 'current'")
+	assert.Assert(t, util.ContainsAll(lines[2], "NAME", "READY", "URL", "LATEST", "TRAFFIC", "AGE"))
+	assert.Assert(t, util.ContainsAll(lines[3], "foo", "True", "http://foo.default.example.com", "foo-00002=80%,foo-00001=20%"))
+	assert.Assert(t, util.ContainsNone(buf.String(), "quit"))
+}
+
+func TestDashboardInvalidArgs(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, _, _ := commands.CreateTestKnCommand(NewDashboardCommand(knParams), knParams)
+	cmd.SetArgs([]string{"dashboard", "foo"})
+	assert.ErrorContains(t, cmd.Execute(), "accepts no arguments")
+
+	cmd.SetArgs([]string{"dashboard", "--refresh", "0s"})
+	assert.ErrorContains(t, cmd.Execute(), "--refresh must be positive")
+}
+
+func TestRunner(t *testing.T) {
+	knParams := &commands.KnParams{}
+	_, fakeServing, _ := commands.CreateTestKnCommand(NewDashboardCommand(knParams), knParams)
+	var deleted string
+	fakeServing.AddReactor("delete", "revisions", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		deleted = a.(clienttesting.DeleteAction).GetName()
+		return true, nil, nil
+	})
+
+	run := newRunner(knParams)
+	out, err := run(context.Background(), "revision", "delete", "foo-00001", "--no-wait", "--namespace", "default")
+	assert.NilError(t, err)
+	assert.Equal(t, deleted, "foo-00001")
+	assert.Assert(t, util.ContainsAll(out, "Revision 'foo-00001' deleted"))
+
+	_, err = run(context.Background(), "revision", "delete")
+	assert.ErrorContains(t, err, "requires one or more revision name")
+}
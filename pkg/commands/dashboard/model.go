@@ -0,0 +1,462 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+type screen int
+
+const (
+	// servicesScreen lists all services of the namespace
+	servicesScreen screen = iota
+	// serviceScreen shows the revisions, routes and conditions of a single service
+	serviceScreen
+	// outputScreen shows the output of an action, like the logs of a revision
+	outputScreen
+)
+
+// logLines is the number of log lines shown per container
+const logLines = 100
+
+// runFunc runs kn with the given arguments and returns the output
+type runFunc func(ctx context.Context, args ...string) (string, error)
+
+// model is the state of the dashboard. All changes are made by running the
+// existing kn commands, the dashboard itself only reads the resources.
+type model struct {
+	ctx       context.Context
+	client    clientservingv1.KnServingClient
+	run       runFunc
+	namespace string
+	refresh   time.Duration
+
+	screen        screen
+	width, height int
+
+	services []servingv1.Service
+	cursor   int
+
+	// service is the name of the service shown on the service screen
+	service        string
+	revisions      []servingv1.Revision
+	route          *servingv1.Route
+	revisionCursor int
+
+	// previous is the screen to return to from the output screen
+	previous    screen
+	outputTitle string
+	output      viewport.Model
+
+	prompt *prompt
+	// status is the result of the last action
+	status string
+	// busy is set while an action is running
+	busy bool
+	err  error
+	// tickScheduled makes sure that only one refresh is scheduled at a time
+	tickScheduled bool
+}
+
+// prompt asks for the input of an action
+type prompt struct {
+	input  textinput.Model
+	submit func(value string) tea.Cmd
+}
+
+type tickMsg struct{}
+
+// refreshMsg holds the fetched resources
+type refreshMsg struct {
+	services  []servingv1.Service
+	service   string
+	revisions []servingv1.Revision
+	route     *servingv1.Route
+	err       error
+}
+
+// actionMsg holds the result of an action
+type actionMsg struct {
+	title  string
+	output string
+	err    error
+	// show is set for actions whose output is shown on the output screen
+	show bool
+}
+
+func newModel(ctx context.Context, client clientservingv1.KnServingClient, run runFunc, namespace string, refresh time.Duration) *model {
+	return &model{
+		ctx:       ctx,
+		client:    client,
+		run:       run,
+		namespace: namespace,
+		refresh:   refresh,
+		output:    viewport.New(80, 20),
+	}
+}
+
+func (m *model) Init() tea.Cmd {
+	return m.fetch()
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.output.Width = msg.Width
+		m.output.Height = max(msg.Height-3, 1)
+		return m, nil
+	case tickMsg:
+		m.tickScheduled = false
+		return m, m.fetch()
+	case refreshMsg:
+		m.applyRefresh(msg)
+		return m, m.scheduleTick()
+	case actionMsg:
+		m.busy = false
+		if msg.err != nil {
+			m.status = fmt.Sprintf("%s failed: %v", msg.title, msg.err)
+			return m, nil
+		}
+		if msg.show {
+			m.showOutput(msg.title, msg.output)
+			return m, nil
+		}
+		m.status = lastLine(msg.output)
+		return m, m.fetch()
+	case tea.KeyMsg:
+		if m.prompt != nil {
+			return m.updatePrompt(msg)
+		}
+		return m.updateKey(msg)
+	}
+	return m, nil
+}
+
+func (m *model) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "r":
+		return m, m.fetch()
+	}
+
+	switch m.screen {
+	case servicesScreen:
+		switch msg.String() {
+		case "up", "k":
+			m.cursor = max(m.cursor-1, 0)
+		case "down", "j":
+			m.cursor = min(m.cursor+1, max(len(m.services)-1, 0))
+		case "enter", "right":
+			if service := m.selectedService(); service != nil {
+				m.screen = serviceScreen
+				m.service = service.Name
+				m.revisions, m.route, m.revisionCursor = nil, nil, 0
+				return m, m.fetch()
+			}
+		case "t":
+			return m, m.promptTraffic()
+		case "l":
+			if service := m.selectedService(); service != nil {
+				return m, m.action(fmt.Sprintf("Logs of service '%s'", service.Name), true,
+					"service", "logs", service.Name, "--tail", strconv.Itoa(logLines))
+			}
+		}
+	case serviceScreen:
+		switch msg.String() {
+		case "up", "k":
+			m.revisionCursor = max(m.revisionCursor-1, 0)
+		case "down", "j":
+			m.revisionCursor = min(m.revisionCursor+1, max(len(m.revisions)-1, 0))
+		case "esc", "left", "backspace":
+			m.screen = servicesScreen
+		case "t":
+			return m, m.promptTraffic()
+		case "g":
+			return m, m.promptTag()
+		case "d":
+			return m, m.promptDelete()
+		case "l":
+			if revision := m.selectedRevision(); revision != nil {
+				return m, m.action(fmt.Sprintf("Logs of revision '%s'", revision.Name), true,
+					"service", "logs", m.service, "--revision", revision.Name, "--tail", strconv.Itoa(logLines))
+			}
+		}
+	case outputScreen:
+		switch msg.String() {
+		case "esc", "left", "backspace":
+			m.screen = m.previous
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.output, cmd = m.output.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m *model) updatePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.prompt = nil
+		return m, nil
+	case tea.KeyEnter:
+		value := strings.TrimSpace(m.prompt.input.Value())
+		submit := m.prompt.submit
+		m.prompt = nil
+		if value == "" {
+			return m, nil
+		}
+		return m, submit(value)
+	}
+	var cmd tea.Cmd
+	m.prompt.input, cmd = m.prompt.input.Update(msg)
+	return m, cmd
+}
+
+// promptTraffic asks for the new traffic split of the selected service, starting with the current one
+func (m *model) promptTraffic() tea.Cmd {
+	service := m.selectedService()
+	if service == nil {
+		return nil
+	}
+	name := service.Name
+	return m.ask(fmt.Sprintf("Traffic of service '%s': ", name), trafficSpec(service), func(value string) tea.Cmd {
+		return m.action(fmt.Sprintf("Updating traffic of service '%s'", name), false,
+			"service", "update", name, "--traffic", value, "--no-wait")
+	})
+}
+
+// promptTag asks for a tag of the selected revision
+func (m *model) promptTag() tea.Cmd {
+	revision := m.selectedRevision()
+	if revision == nil {
+		return nil
+	}
+	name, service := revision.Name, m.service
+	return m.ask(fmt.Sprintf("Tag of revision '%s': ", name), "", func(value string) tea.Cmd {
+		return m.action(fmt.Sprintf("Tagging revision '%s'", name), false,
+			"service", "update", service, "--tag", name+"="+value, "--no-wait")
+	})
+}
+
+// promptDelete asks for confirming the deletion of the selected revision
+func (m *model) promptDelete() tea.Cmd {
+	revision := m.selectedRevision()
+	if revision == nil {
+		return nil
+	}
+	name := revision.Name
+	return m.ask(fmt.Sprintf("Delete revision '%s'? (y/N): ", name), "", func(value string) tea.Cmd {
+		if !strings.HasPrefix(strings.ToLower(value), "y") {
+			return nil
+		}
+		return m.action(fmt.Sprintf("Deleting revision '%s'", name), false,
+			"revision", "delete", name, "--no-wait")
+	})
+}
+
+func (m *model) ask(label, value string, submit func(value string) tea.Cmd) tea.Cmd {
+	if m.busy {
+		return nil
+	}
+	input := textinput.New()
+	input.Prompt = label
+	input.SetValue(value)
+	m.prompt = &prompt{input: input, submit: submit}
+	return m.prompt.input.Focus()
+}
+
+// action runs kn with the given arguments in the namespace of the dashboard
+func (m *model) action(title string, show bool, args ...string) tea.Cmd {
+	if m.busy {
+		return nil
+	}
+	m.busy = true
+	m.status = title + " ..."
+	ctx, run := m.ctx, m.run
+	args = append(args, "--namespace", m.namespace)
+	return func() tea.Msg {
+		output, err := run(ctx, args...)
+		return actionMsg{title: title, output: output, err: err, show: show}
+	}
+}
+
+func (m *model) showOutput(title, output string) {
+	if m.screen != outputScreen {
+		m.previous = m.screen
+	}
+	m.screen = outputScreen
+	m.outputTitle = title
+	m.status = ""
+	if strings.TrimSpace(output) == "" {
+		output = "No output."
+	}
+	m.output.SetContent(output)
+	m.output.GotoBottom()
+}
+
+// fetch returns the command for fetching the services and, on the service screen, the
+// details of the shown service
+func (m *model) fetch() tea.Cmd {
+	ctx, client := m.ctx, m.client
+	service := ""
+	if m.screen != servicesScreen {
+		service = m.service
+	}
+	return func() tea.Msg {
+		msg := refreshMsg{service: service}
+		services, err := client.ListServices(ctx)
+		if err != nil {
+			msg.err = err
+			return msg
+		}
+		msg.services = services.Items
+		sort.SliceStable(msg.services, func(i, j int) bool {
+			return msg.services[i].Name < msg.services[j].Name
+		})
+		if service == "" {
+			return msg
+		}
+		revisions, err := client.ListRevisions(ctx, clientservingv1.WithService(service))
+		if err != nil {
+			msg.err = err
+			return msg
+		}
+		msg.revisions = revisions.Items
+		sortRevisions(msg.revisions)
+		route, err := client.GetRoute(ctx, service)
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			msg.err = err
+		default:
+			msg.route = route
+		}
+		return msg
+	}
+}
+
+func (m *model) applyRefresh(msg refreshMsg) {
+	m.err = msg.err
+	if msg.err != nil {
+		return
+	}
+	// keep the selected service selected if services have been added or removed
+	selected := m.selectedService()
+	m.services = msg.services
+	if selected != nil {
+		for i := range m.services {
+			if m.services[i].Name == selected.Name {
+				m.cursor = i
+			}
+		}
+	}
+	m.cursor = min(m.cursor, max(len(m.services)-1, 0))
+	if msg.service != "" && msg.service == m.service {
+		m.revisions, m.route = msg.revisions, msg.route
+		m.revisionCursor = min(m.revisionCursor, max(len(m.revisions)-1, 0))
+	}
+}
+
+func (m *model) scheduleTick() tea.Cmd {
+	if m.tickScheduled {
+		return nil
+	}
+	m.tickScheduled = true
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+// selectedService returns the service selected on the services screen or shown on the
+// service screen, nil if there is none
+func (m *model) selectedService() *servingv1.Service {
+	if m.screen == servicesScreen {
+		if m.cursor < len(m.services) {
+			return &m.services[m.cursor]
+		}
+		return nil
+	}
+	return m.findService(m.service)
+}
+
+func (m *model) findService(name string) *servingv1.Service {
+	for i := range m.services {
+		if m.services[i].Name == name {
+			return &m.services[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) selectedRevision() *servingv1.Revision {
+	if m.screen != serviceScreen || m.revisionCursor >= len(m.revisions) {
+		return nil
+	}
+	return &m.revisions[m.revisionCursor]
+}
+
+// trafficSpec returns the traffic split of the service in the format of the --traffic flag
+func trafficSpec(service *servingv1.Service) string {
+	var targets []string
+	for _, target := range service.Spec.Traffic {
+		if target.Percent == nil || *target.Percent == 0 {
+			continue
+		}
+		revision := target.RevisionName
+		if target.LatestRevision != nil && *target.LatestRevision {
+			revision = "@latest"
+		}
+		targets = append(targets, fmt.Sprintf("%s=%d", revision, *target.Percent))
+	}
+	if len(targets) == 0 {
+		return "@latest=100"
+	}
+	return strings.Join(targets, ",")
+}
+
+// sortRevisions sorts the revisions with the newest first
+func sortRevisions(revisions []servingv1.Revision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		a, b := revisions[i].CreationTimestamp, revisions[j].CreationTimestamp
+		if !a.Equal(&b) {
+			return b.Before(&a)
+		}
+		return revisions[i].Name > revisions[j].Name
+	})
+}
+
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return lines[len(lines)-1]
+}
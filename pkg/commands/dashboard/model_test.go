@@ -0,0 +1,230 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingfake "knative.dev/serving/pkg/client/clientset/versioned/fake"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDashboard(t *testing.T) {
+	var runs [][]string
+	runOutput := "Service 'foo' updated in namespace 'default'.\n"
+	m := newTestModel(func(ctx context.Context, args ...string) (string, error) {
+		runs = append(runs, args)
+		return runOutput, nil
+	}, newService("bar", "bar-00001"), newService("foo", "foo-00002"),
+		newRevision("foo", "foo-00001", 10), newRevision("foo", "foo-00002", 5),
+		newRoute("foo"))
+
+	update(t, m, m.Init())
+	view := m.View()
+	assert.Assert(t, util.ContainsAll(view, "Services in namespace 'default'", "NAME", "READY", "URL", "LATEST", "TRAFFIC"))
+	assert.Assert(t, util.ContainsAll(view, "bar", "http://bar.default.example.com", "bar-00001=100%"))
+	assert.Assert(t, util.ContainsAll(view, "foo", "foo-00002", "foo-00002=80%,foo-00001=20%"))
+
+	// open the details of foo
+	press(m, "down")
+	update(t, m, press(m, "enter"))
+	assert.Equal(t, m.screen, serviceScreen)
+	view = m.View()
+	assert.Assert(t, util.ContainsAll(view, "Service 'foo' in namespace 'default'", "Revisions", "Routes", "Conditions"))
+	assert.Assert(t, strings.Index(view, "foo-00002   80%") < strings.Index(view, "foo-00001   20%       old"))
+	assert.Assert(t, util.ContainsAll(view, "old", "http://old-foo.default.example.com", "ConfigurationsReady"))
+
+	// tag the selected revision
+	press(m, "g")
+	assert.Assert(t, m.prompt != nil)
+	typeText(m, "candidate")
+	update(t, m, press(m, "enter"))
+	assert.DeepEqual(t, runs[0], []string{"service", "update", "foo", "--tag", "foo-00002=candidate", "--no-wait", "--namespace", "default"})
+	assert.Equal(t, m.status, "Service 'foo' updated in namespace 'default'.")
+
+	// update the traffic, starting with the current split
+	press(m, "t")
+	assert.Equal(t, m.prompt.input.Value(), "foo-00002=80,foo-00001=20")
+	m.prompt.input.SetValue("@latest=100")
+	update(t, m, press(m, "enter"))
+	assert.DeepEqual(t, runs[1], []string{"service", "update", "foo", "--traffic", "@latest=100", "--no-wait", "--namespace", "default"})
+
+	// deleting the revision needs to be confirmed
+	press(m, "down")
+	press(m, "d")
+	typeText(m, "n")
+	update(t, m, press(m, "enter"))
+	assert.Equal(t, len(runs), 2)
+	press(m, "d")
+	typeText(m, "y")
+	update(t, m, press(m, "enter"))
+	assert.DeepEqual(t, runs[2], []string{"revision", "delete", "foo-00001", "--no-wait", "--namespace", "default"})
+
+	// logs are shown on their own screen
+	runOutput = "foo-00001-deployment-1 user-container: started\n"
+	update(t, m, press(m, "l"))
+	assert.DeepEqual(t, runs[3], []string{"service", "logs", "foo", "--revision", "foo-00001", "--tail", "100", "--namespace", "default"})
+	assert.Equal(t, m.screen, outputScreen)
+	assert.Assert(t, util.ContainsAll(m.View(), "Logs of revision 'foo-00001'", "user-container: started", "esc back"))
+	press(m, "esc")
+	assert.Equal(t, m.screen, serviceScreen)
+	press(m, "esc")
+	assert.Equal(t, m.screen, servicesScreen)
+
+	_, cmd := m.Update(key("q"))
+	assert.Equal(t, cmd(), tea.Quit())
+}
+
+func TestDashboardErrors(t *testing.T) {
+	m := newTestModel(func(ctx context.Context, args ...string) (string, error) {
+		return "", errors.New("admission webhook denied the request")
+	}, newService("foo", "foo-00001"))
+	update(t, m, m.Init())
+
+	press(m, "t")
+	update(t, m, press(m, "enter"))
+	assert.Equal(t, m.status, "Updating traffic of service 'foo' failed: admission webhook denied the request")
+
+	// the prompt is cancelled with escape or an empty value
+	press(m, "t")
+	press(m, "esc")
+	assert.Assert(t, m.prompt == nil)
+
+	m.client = clientservingv1.NewKnServingClient(servingfake.NewSimpleClientset().ServingV1(), "other")
+	update(t, m, press(m, "r"))
+	assert.Assert(t, util.ContainsAll(m.View(), "No services found."))
+
+	m.Update(refreshMsg{err: errors.New("connection refused")})
+	assert.Assert(t, util.ContainsAll(m.View(), "Error: connection refused"))
+}
+
+func TestDashboardRefresh(t *testing.T) {
+	m := newTestModel(nil, newService("foo", "foo-00001"))
+	cmd := m.Init()
+	_, tick := m.Update(cmd())
+	assert.Assert(t, tick != nil)
+	// a manual refresh doesn't schedule another tick
+	_, cmd = m.Update(m.fetch()())
+	assert.Assert(t, cmd == nil)
+	_, cmd = m.Update(tickMsg{})
+	_, tick = m.Update(cmd())
+	assert.Assert(t, tick != nil)
+}
+
+func newTestModel(run runFunc, objects ...runtime.Object) *model {
+	client := clientservingv1.NewKnServingClient(servingfake.NewSimpleClientset(objects...).ServingV1(), "default")
+	return newModel(context.Background(), client, run, "default", time.Second)
+}
+
+// update runs the given command and the commands resulting from its messages, apart from
+// scheduled refreshes, like the bubbletea program does
+func update(t *testing.T, m *model, cmd tea.Cmd) {
+	t.Helper()
+	for cmd != nil {
+		msg := cmd()
+		if _, ok := msg.(refreshMsg); ok {
+			m.Update(msg)
+			return
+		}
+		if _, ok := msg.(actionMsg); !ok {
+			return
+		}
+		_, cmd = m.Update(msg)
+	}
+}
+
+// press sends the key to the model and returns the resulting command. The commands of
+// keys opening a prompt aren't run, as they only blink the cursor.
+func press(m *model, k string) tea.Cmd {
+	_, cmd := m.Update(key(k))
+	return cmd
+}
+
+// typeText sends the text to the prompt of the model
+func typeText(m *model, text string) {
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+}
+
+func key(k string) tea.KeyMsg {
+	switch k {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+func newService(name, latest string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	service.Status.URL = &apis.URL{Scheme: "http", Host: name + ".default.example.com"}
+	service.Status.LatestCreatedRevisionName = latest
+	service.Status.Conditions = []apis.Condition{
+		{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+		{Type: servingv1.ServiceConditionConfigurationsReady, Status: corev1.ConditionTrue},
+	}
+	if name == "foo" {
+		service.Spec.Traffic = []servingv1.TrafficTarget{
+			{RevisionName: "foo-00002", Percent: ptr.Int64(80)},
+			{RevisionName: "foo-00001", Percent: ptr.Int64(20), Tag: "old"},
+		}
+		service.Status.Traffic = service.Spec.Traffic
+	} else {
+		service.Spec.Traffic = []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+		service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: latest, LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	}
+	return service
+}
+
+func newRevision(service, name string, minutes int) *servingv1.Revision {
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         "default",
+		Labels:            map[string]string{serving.ServiceLabelKey: service},
+		CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Duration(minutes) * time.Minute)),
+	}}
+	revision.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+	return revision
+}
+
+func newRoute(service string) *servingv1.Route {
+	route := &servingv1.Route{ObjectMeta: metav1.ObjectMeta{Name: service, Namespace: "default"}}
+	route.Status.URL = &apis.URL{Scheme: "http", Host: service + ".default.example.com"}
+	route.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: service + "-00002", Percent: ptr.Int64(80)},
+		{RevisionName: service + "-00001", Percent: ptr.Int64(20), Tag: "old",
+			URL: &apis.URL{Scheme: "http", Host: "old-" + service + ".default.example.com"}},
+	}
+	return route
+}
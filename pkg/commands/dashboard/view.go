@@ -0,0 +1,207 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/charmbracelet/lipgloss"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	headerStyle   = lipgloss.NewStyle().Faint(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle     = lipgloss.NewStyle().Faint(true)
+)
+
+var help = map[screen]string{
+	servicesScreen: "↑/↓ select • enter details • t traffic • l logs • r refresh • q quit",
+	serviceScreen:  "↑/↓ select revision • t traffic • g tag • d delete • l logs • esc back • q quit",
+	outputScreen:   "↑/↓ scroll • esc back • q quit",
+}
+
+func (m *model) View() string {
+	b := &strings.Builder{}
+	switch m.screen {
+	case servicesScreen:
+		m.viewServices(b, true)
+	case serviceScreen:
+		m.viewService(b)
+	case outputScreen:
+		fmt.Fprintln(b, titleStyle.Render(m.outputTitle))
+		fmt.Fprintln(b, m.output.View())
+	}
+
+	fmt.Fprintln(b)
+	if m.err != nil {
+		fmt.Fprintln(b, errorStyle.Render("Error: "+m.err.Error()))
+	}
+	switch {
+	case m.prompt != nil:
+		fmt.Fprintln(b, m.prompt.input.View())
+	case m.status != "":
+		fmt.Fprintln(b, m.status)
+	}
+	fmt.Fprint(b, helpStyle.Render(help[m.screen]))
+	return b.String()
+}
+
+// viewServices renders the services with their readiness, URL, latest revision and traffic split
+func (m *model) viewServices(b *strings.Builder, selectable bool) {
+	fmt.Fprintln(b, titleStyle.Render(fmt.Sprintf("Services in namespace '%s'", m.namespace)))
+	fmt.Fprintln(b)
+	if len(m.services) == 0 {
+		fmt.Fprintln(b, "No services found.")
+		return
+	}
+	rows := make([][]string, 0, len(m.services))
+	for _, service := range m.services {
+		rows = append(rows, []string{
+			service.Name,
+			commands.ReadyCondition(service.Status.Conditions),
+			service.Status.URL.String(),
+			service.Status.LatestCreatedRevisionName,
+			trafficSplit(service.Status.Traffic),
+			commands.TranslateTimestampSince(service.CreationTimestamp),
+		})
+	}
+	selected := -1
+	if selectable {
+		selected = m.cursor
+	}
+	renderTable(b, []string{"NAME", "READY", "URL", "LATEST", "TRAFFIC", "AGE"}, rows, selected)
+}
+
+// viewService renders the revisions, routes and conditions of the shown service
+func (m *model) viewService(b *strings.Builder) {
+	fmt.Fprintln(b, titleStyle.Render(fmt.Sprintf("Service '%s' in namespace '%s'", m.service, m.namespace)))
+	service := m.findService(m.service)
+	if service == nil {
+		fmt.Fprintln(b)
+		fmt.Fprintln(b, "Service not found, it might have been deleted.")
+		return
+	}
+	fmt.Fprintln(b, service.Status.URL.String())
+
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, titleStyle.Render("Revisions"))
+	if len(m.revisions) == 0 {
+		fmt.Fprintln(b, "No revisions found.")
+	} else {
+		percents, tags := revisionTraffic(service.Status.Traffic)
+		rows := make([][]string, 0, len(m.revisions))
+		for _, revision := range m.revisions {
+			traffic := ""
+			if percent, ok := percents[revision.Name]; ok {
+				traffic = fmt.Sprintf("%d%%", percent)
+			}
+			rows = append(rows, []string{
+				revision.Name,
+				traffic,
+				strings.Join(tags[revision.Name], ","),
+				commands.ReadyCondition(revision.Status.Conditions),
+				commands.NonReadyConditionReason(revision.Status.Conditions),
+				commands.TranslateTimestampSince(revision.CreationTimestamp),
+			})
+		}
+		renderTable(b, []string{"NAME", "TRAFFIC", "TAGS", "READY", "REASON", "AGE"}, rows, m.revisionCursor)
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, titleStyle.Render("Routes"))
+	if m.route == nil {
+		fmt.Fprintln(b, "No route found.")
+	} else {
+		rows := [][]string{{"@default", m.route.Status.URL.String(), ""}}
+		for _, target := range m.route.Status.Traffic {
+			if target.Tag == "" {
+				continue
+			}
+			rows = append(rows, []string{target.Tag, target.URL.String(), target.RevisionName})
+		}
+		renderTable(b, []string{"TAG", "URL", "REVISION"}, rows, -1)
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, titleStyle.Render("Conditions"))
+	rows := make([][]string, 0, len(service.Status.Conditions))
+	for _, condition := range service.Status.Conditions {
+		rows = append(rows, []string{string(condition.Type), string(condition.Status), condition.Reason, condition.Message})
+	}
+	renderTable(b, []string{"TYPE", "STATUS", "REASON", "MESSAGE"}, rows, -1)
+}
+
+// snapshot renders the services without any interaction, for printing when not running in a terminal
+func (m *model) snapshot() string {
+	b := &strings.Builder{}
+	m.viewServices(b, false)
+	return b.String()
+}
+
+// renderTable renders the rows aligned in columns, highlighting the selected row
+func renderTable(b *strings.Builder, headers []string, rows [][]string, selected int) {
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case i == 0:
+			line = headerStyle.Render(line)
+		case i-1 == selected:
+			line = selectedStyle.Render(line)
+		}
+		fmt.Fprintln(b, line)
+	}
+}
+
+// trafficSplit renders the revisions receiving traffic with their percentages
+func trafficSplit(traffic []servingv1.TrafficTarget) string {
+	var split []string
+	for _, target := range traffic {
+		if target.Percent == nil || *target.Percent == 0 {
+			continue
+		}
+		split = append(split, fmt.Sprintf("%s=%d%%", target.RevisionName, *target.Percent))
+	}
+	return strings.Join(split, ",")
+}
+
+// revisionTraffic returns the percentage and tags of each revision referenced by the traffic targets
+func revisionTraffic(traffic []servingv1.TrafficTarget) (map[string]int64, map[string][]string) {
+	percents := map[string]int64{}
+	tags := map[string][]string{}
+	for _, target := range traffic {
+		if target.Percent != nil {
+			percents[target.RevisionName] += *target.Percent
+		}
+		if target.Tag != "" {
+			tags[target.RevisionName] = append(tags[target.RevisionName], target.Tag)
+		}
+	}
+	return percents, tags
+}
@@ -45,7 +45,7 @@ func NewDomainMappingCreateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -63,7 +63,7 @@ func NewDomainMappingCreateCommand(p *commands.KnParams) *cobra.Command {
 				Reference(*reference).
 				TLS(refFlags.tls)
 
-			client, err := p.NewServingV1beta1Client(namespace)
+			client, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsServingV1beta1Client, p.NewServingV1beta1Client)
 			if err != nil {
 				return err
 			}
@@ -78,6 +78,7 @@ func NewDomainMappingCreateCommand(p *commands.KnParams) *cobra.Command {
 	}
 	cmd.Flags().StringVar(&refFlags.tls, "tls", "", "Enable TLS and point to the secret that holds the server certificate.")
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	refFlags.Add(cmd)
 	cmd.MarkFlagRequired("ref")
 	return cmd
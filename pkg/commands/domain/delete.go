@@ -44,7 +44,7 @@ func NewDomainMappingDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			client, err := p.NewServingV1beta1Client(namespace)
+			client, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsServingV1beta1Client, p.NewServingV1beta1Client)
 			if err != nil {
 				return err
 			}
@@ -59,5 +59,6 @@ func NewDomainMappingDeleteCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	return cmd
 }
@@ -48,7 +48,7 @@ func NewDomainMappingDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			client, err := p.NewServingV1beta1Client(namespace)
+			client, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsServingV1beta1Client, p.NewServingV1beta1Client)
 			if err != nil {
 				return err
 			}
@@ -78,6 +78,7 @@ func NewDomainMappingDescribeCommand(p *commands.KnParams) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
 	machineReadablePrintFlags.AddFlags(cmd)
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	flags.BoolP("verbose", "v", false, "More output.")
@@ -60,6 +60,12 @@ var refMappings = map[string]schema.GroupVersionResource{
 	},
 }
 
+// refKinds are the kinds of the refMappings, for resolving references without a cluster
+var refKinds = map[string]string{
+	"ksvc":   "Service",
+	"kroute": "Route",
+}
+
 func (f *RefFlags) Add(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&f.reference, "ref", "", "")
 	cmd.Flag("ref").Usage = "Addressable target reference for Domain Mapping. " +
@@ -71,8 +77,9 @@ func (f *RefFlags) Add(cmd *cobra.Command) {
 		"If referring to a Knative service in another namespace, 'ksvc:name:namespace' combination must be provided explicitly."
 }
 
+// Resolve looks up the referenced object. If knclient is nil, the reference is
+// resolved without checking that the object exists.
 func (f RefFlags) Resolve(ctx context.Context, knclient clientdynamic.KnDynamicClient, namespace string) (*duckv1.KReference, error) {
-	client := knclient.RawClient()
 	if f.reference == "" {
 		return nil, nil
 	}
@@ -85,7 +92,15 @@ func (f RefFlags) Resolve(ctx context.Context, knclient clientdynamic.KnDynamicC
 	if refNamespace != "" {
 		namespace = refNamespace
 	}
-	obj, err := client.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if knclient == nil {
+		return &duckv1.KReference{
+			Kind:       refKinds[prefix],
+			APIVersion: gvr.GroupVersion().String(),
+			Name:       name,
+			Namespace:  namespace,
+		}, nil
+	}
+	obj, err := knclient.RawClient().Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +139,22 @@ func TestResolve(t *testing.T) {
 			assert.ErrorContains(t, err, c.errContents)
 		}
 	}
+
+	// Without a cluster, references are resolved without being looked up
+	for _, c := range cases {
+		i := &RefFlags{reference: c.ref}
+		result, err := i.Resolve(context.Background(), nil, "default")
+		if c.destination != nil {
+			assert.DeepEqual(t, result, c.destination)
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, c.errContents)
+		}
+	}
+	i := &RefFlags{reference: "ksvc:unknown"}
+	result, err := i.Resolve(context.Background(), nil, "default")
+	assert.NilError(t, err)
+	assert.Equal(t, result.Name, "unknown")
 }
 
 func TestRefFlagAdd(t *testing.T) {
@@ -45,7 +45,7 @@ func NewDomainMappingListCommand(p *commands.KnParams) *cobra.Command {
 				listFlags.EnsureWithNamespace()
 			}
 
-			client, err := p.NewServingV1beta1Client(namespace)
+			client, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsServingV1beta1Client, p.NewServingV1beta1Client)
 			if err != nil {
 				return err
 			}
@@ -64,6 +64,7 @@ func NewDomainMappingListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
+	commands.AddGitOpsFlags(cmd.Flags())
 	listFlags.AddFlags(cmd)
 	return cmd
 }
@@ -47,7 +47,7 @@ func NewDomainMappingUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			client, err := p.NewServingV1beta1Client(namespace)
+			client, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsServingV1beta1Client, p.NewServingV1beta1Client)
 			if err != nil {
 				return err
 			}
@@ -57,7 +57,7 @@ func NewDomainMappingUpdateCommand(p *commands.KnParams) *cobra.Command {
 					return nil, fmt.Errorf("can't update domain mapping '%s' because it has been marked for deletion", name)
 				}
 
-				dynamicClient, err := p.NewDynamicClient(namespace)
+				dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 				if err != nil {
 					return nil, err
 				}
@@ -80,6 +80,7 @@ func NewDomainMappingUpdateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	refFlags.Add(cmd)
 	cmd.MarkFlagRequired("refFlags")
 	return cmd
@@ -0,0 +1,119 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+const dryRunNone = "none"
+
+// DryRunFlags are the flags for previewing mutating operations without persisting them
+type DryRunFlags struct {
+	// DryRun is one of "none", "client" or "server"
+	DryRun string
+
+	// printFlags for printing the resulting object, only set if output flags have been added
+	printFlags *genericclioptions.PrintFlags
+}
+
+// Add the --dry-run flag to the given command. Use `what` for describing the
+// object which is mutated.
+func (f *DryRunFlags) Add(command *cobra.Command, what string) {
+	command.Flags().StringVar(&f.DryRun, "dry-run", dryRunNone,
+		fmt.Sprintf("Preview the %s without persisting it. One of: none|client|server. With 'client' nothing is sent "+
+			"to the cluster, with 'server' the request is fully validated by the API server, including admission webhooks.", what))
+}
+
+// AddOutputFlags adds the flags for printing the object resulting from a dry-run in a
+// machine readable format
+func (f *DryRunFlags) AddOutputFlags(command *cobra.Command) {
+	f.printFlags = genericclioptions.NewPrintFlags("")
+	f.printFlags.AddFlags(command)
+	command.Flag("output").Usage = fmt.Sprintf("Output format of the object resulting from --dry-run. One of: %s.",
+		strings.Join(f.printFlags.AllowedFormats(), "|"))
+}
+
+// Mode returns the dry-run mode selected with --dry-run
+func (f *DryRunFlags) Mode() (clientservingv1.DryRunMode, error) {
+	switch f.DryRun {
+	case "", dryRunNone:
+		return clientservingv1.DryRunNone, nil
+	case string(clientservingv1.DryRunClient):
+		return clientservingv1.DryRunClient, nil
+	case string(clientservingv1.DryRunServer):
+		return clientservingv1.DryRunServer, nil
+	}
+	return clientservingv1.DryRunNone, fmt.Errorf("invalid value '%s' for '--dry-run', must be one of 'none', 'client' or 'server'", f.DryRun)
+}
+
+// Enabled returns true if a dry-run has been requested
+func (f *DryRunFlags) Enabled() bool {
+	mode, err := f.Mode()
+	return err == nil && mode != clientservingv1.DryRunNone
+}
+
+// Validate checks the dry-run mode and its combination with the output and --target flags
+func (f *DryRunFlags) Validate(cmd *cobra.Command) error {
+	mode, err := f.Mode()
+	if err != nil {
+		return err
+	}
+	if mode == clientservingv1.DryRunNone && f.OutputFlagSpecified() {
+		return errors.New("'--output' can only be used together with '--dry-run'")
+	}
+	if target := cmd.Flag("target"); mode == clientservingv1.DryRunServer && target != nil && target.Value.String() != "" {
+		return errors.New("'--dry-run=server' can't be used together with '--target', use '--dry-run=client' instead")
+	}
+	return nil
+}
+
+// WithContext returns a context which carries the selected dry-run mode for the serving client
+func (f *DryRunFlags) WithContext(ctx context.Context) context.Context {
+	mode, err := f.Mode()
+	if err != nil {
+		return ctx
+	}
+	return clientservingv1.WithDryRun(ctx, mode)
+}
+
+// OutputFlagSpecified returns true if an output format has been requested
+func (f *DryRunFlags) OutputFlagSpecified() bool {
+	return f.printFlags != nil && f.printFlags.OutputFlagSpecified()
+}
+
+// PrintResult prints the object resulting from a dry-run in the requested output format,
+// or the given message (suffixed with the dry-run mode) if no output format is given
+func (f *DryRunFlags) PrintResult(out io.Writer, obj runtime.Object, message string) error {
+	if f.OutputFlagSpecified() {
+		printer, err := f.printFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(obj, out)
+	}
+	fmt.Fprintf(out, "%s (%s dry run).\n", message, f.DryRun)
+	return nil
+}
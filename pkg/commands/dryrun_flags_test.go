@@ -0,0 +1,86 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+func TestDryRunFlagsMode(t *testing.T) {
+	for _, tc := range []struct {
+		args    []string
+		mode    clientservingv1.DryRunMode
+		enabled bool
+		err     string
+	}{
+		{[]string{}, clientservingv1.DryRunNone, false, ""},
+		{[]string{"--dry-run=none"}, clientservingv1.DryRunNone, false, ""},
+		{[]string{"--dry-run=client"}, clientservingv1.DryRunClient, true, ""},
+		{[]string{"--dry-run=server"}, clientservingv1.DryRunServer, true, ""},
+		{[]string{"--dry-run=all"}, clientservingv1.DryRunNone, false, "invalid value 'all'"},
+		{[]string{"-o", "yaml"}, clientservingv1.DryRunNone, false, "'--output' can only be used"},
+		{[]string{"--dry-run=server", "--target", "/tmp"}, clientservingv1.DryRunServer, true, "'--target'"},
+		{[]string{"--dry-run=client", "--target", "/tmp"}, clientservingv1.DryRunClient, true, ""},
+	} {
+		flags := &DryRunFlags{}
+		cmd := &cobra.Command{}
+		flags.Add(cmd, "service creation")
+		flags.AddOutputFlags(cmd)
+		AddGitOpsFlags(cmd.Flags())
+		assert.NilError(t, cmd.ParseFlags(tc.args))
+
+		err := flags.Validate(cmd)
+		if tc.err != "" {
+			assert.ErrorContains(t, err, tc.err)
+			continue
+		}
+		assert.NilError(t, err)
+		mode, err := flags.Mode()
+		assert.NilError(t, err)
+		assert.Equal(t, mode, tc.mode)
+		assert.Equal(t, flags.Enabled(), tc.enabled)
+		assert.Equal(t, clientservingv1.DryRunFrom(flags.WithContext(context.Background())), tc.mode)
+	}
+}
+
+func TestDryRunFlagsPrintResult(t *testing.T) {
+	obj := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+	}
+
+	flags := &DryRunFlags{}
+	cmd := &cobra.Command{}
+	flags.Add(cmd, "creation")
+	flags.AddOutputFlags(cmd)
+	assert.NilError(t, cmd.ParseFlags([]string{"--dry-run=server"}))
+	out := &bytes.Buffer{}
+	assert.NilError(t, flags.PrintResult(out, obj, "ConfigMap 'foo' created"))
+	assert.Equal(t, out.String(), "ConfigMap 'foo' created (server dry run).\n")
+
+	assert.NilError(t, cmd.ParseFlags([]string{"-o", "yaml"}))
+	out.Reset()
+	assert.NilError(t, flags.PrintResult(out, obj, "ConfigMap 'foo' created"))
+	assert.Assert(t, bytes.Contains(out.Bytes(), []byte("name: foo")))
+}
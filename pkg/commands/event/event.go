@@ -0,0 +1,37 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+)
+
+// NewEventCommand represents the commands for sending and receiving CloudEvents
+func NewEventCommand(p *commands.KnParams) *cobra.Command {
+	eventCmd := &cobra.Command{
+		Use:   "event SUBCOMMAND",
+		Short: "Send and receive CloudEvents",
+		Long: `Send and receive CloudEvents
+
+Events can be sent to any addressable resource like a broker, a channel or a service,
+for example to test the triggers of a broker.`,
+	}
+	eventCmd.AddCommand(NewEventSendCommand(p))
+	eventCmd.AddCommand(NewEventListenCommand(p))
+	eventCmd.AddCommand(NewEventReceiveCommand(p))
+	return eventCmd
+}
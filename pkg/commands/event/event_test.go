@@ -0,0 +1,77 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"knative.dev/client/pkg/commands"
+	clientdynamic "knative.dev/client/pkg/dynamic"
+)
+
+var blankConfig clientcmd.ClientConfig
+
+func init() {
+	var err error
+	blankConfig, err = clientcmd.NewClientConfigFromBytes([]byte(`kind: Config
+version: v1
+users:
+- name: u
+clusters:
+- name: c
+  cluster:
+    server: example.com
+contexts:
+- name: x
+  context:
+    user: u
+    cluster: c
+current-context: x
+`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newTestParams(dynamicClient clientdynamic.KnDynamicClient, kubeClient kubernetes.Interface) *commands.KnParams {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewDynamicClient = func(namespace string) (clientdynamic.KnDynamicClient, error) {
+		return dynamicClient, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	return knParams
+}
+
+func executeEventCommand(dynamicClient clientdynamic.KnDynamicClient, kubeClient kubernetes.Interface, args ...string) (string, error) {
+	return executeEventCommandWithParams(newTestParams(dynamicClient, kubeClient), args...)
+}
+
+func executeEventCommandWithParams(knParams *commands.KnParams, args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	knParams.Output = output
+
+	cmd := NewEventCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	err := cmd.Execute()
+	return output.String(), err
+}
@@ -0,0 +1,437 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	ce "github.com/cloudevents/sdk-go/v2/event"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/commands/flags"
+	"knative.dev/client/pkg/commands/version"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	knflags "knative.dev/client/pkg/flags"
+	"knative.dev/client/pkg/flags/sink"
+	clientmessagingv1 "knative.dev/client/pkg/messaging/v1"
+	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
+)
+
+// receiverImageRepository is the repository of the kn release images, which are used
+// for the receiver pod running 'kn event receive'
+const receiverImageRepository = "gcr.io/knative-releases/knative.dev/client/cmd/kn"
+
+// receiverPort is the port the receiver pod accepts events on
+const receiverPort = 8080
+
+var listenExample = `
+  # Print the events sent to broker 'default'
+  kn event listen --on broker:default
+
+  # Print the events of type 'dev.example.ping' sent to broker 'default'
+  kn event listen --on broker:default --filter type=dev.example.ping
+
+  # Print the events sent to channel 'pipe' as JSON, one event per line
+  kn event listen --on channel:pipe -o json`
+
+// listenFlags are the flags for the resource to listen on and how to print the events
+type listenFlags struct {
+	on            flags.SinkFlags
+	filters       []string
+	output        string
+	receiverImage string
+	timeout       time.Duration
+}
+
+// NewEventListenCommand represents 'kn event listen' command
+func NewEventListenCommand(p *commands.KnParams) *cobra.Command {
+	var f listenFlags
+
+	command := &cobra.Command{
+		Use:   "listen --on BROKER|CHANNEL",
+		Short: "Print the events sent to a broker or a channel",
+		Long: `Print the events sent to a broker or a channel
+
+A temporary receiver pod with a service is deployed in the namespace of the broker or
+channel, which is subscribed by a temporary trigger or subscription. The events it
+receives are printed until Ctrl-C is pressed, which removes all temporary resources.`,
+		Example: listenExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn event listen' doesn't accept arguments")
+			}
+			switch f.output {
+			case "", "json":
+			default:
+				return fmt.Errorf("invalid value for output flag, choose 'json'")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			ref, err := f.on.Parse(namespace)
+			if err != nil {
+				return err
+			}
+			isBroker := ref.Type() == sink.TypeReference && ref.GVR == sink.DefaultMappings["broker"]
+			isChannel := ref.Type() == sink.TypeReference && ref.GVR == sink.DefaultMappings["channel"]
+			if !isBroker && !isChannel {
+				return fmt.Errorf("--on has to reference a broker or a channel, e.g. 'broker:default', given '%s'", f.on.Sink)
+			}
+			if isChannel && len(f.filters) > 0 {
+				return errors.New("--filter can only be used when listening on a broker")
+			}
+			filters, err := util.MapFromArray(f.filters, "=")
+			if err != nil {
+				return fmt.Errorf("Invalid --filter: %w", err)
+			}
+			image, err := receiverImage(f.receiverImage)
+			if err != nil {
+				return err
+			}
+			kubeClient, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			l := &listener{
+				client:    kubeClient,
+				namespace: ref.Namespace,
+				name:      "kn-event-listener-" + rand.String(5),
+				errOut:    cmd.ErrOrStderr(),
+			}
+			defer l.cleanup()
+
+			if err := l.deployReceiver(ctx, image, f.timeout); err != nil {
+				return err
+			}
+			subscriber := &duckv1.Destination{Ref: &duckv1.KReference{Kind: "Service", APIVersion: "v1", Name: l.name, Namespace: ref.Namespace}}
+			if isBroker {
+				err = l.createTrigger(ctx, p, ref.Name, filters, subscriber, f.timeout)
+			} else {
+				err = l.createSubscription(ctx, p, ref.Name, subscriber, f.timeout)
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					// stopped by the user
+					return nil
+				}
+				return err
+			}
+
+			logs, err := kubeClient.CoreV1().Pods(ref.Namespace).GetLogs(l.name, &corev1.PodLogOptions{Follow: true}).Stream(ctx)
+			if err != nil {
+				return err
+			}
+			defer logs.Close()
+			fmt.Fprintf(cmd.ErrOrStderr(), "Listening for events sent to '%s', press Ctrl-C to stop.\n", ref.AsText(namespace))
+			err = printEvents(cmd.OutOrStdout(), logs, f.output)
+			if ctx.Err() != nil {
+				// stopped by the user
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("receiver pod '%s' stopped unexpectedly", l.name)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	f.on.AddWithFlagName(command, "on", "")
+	command.Flag("on").Usage = "Broker or channel to listen on, e.g. 'broker:default' or 'channel:pipe'."
+	command.Flags().StringSliceVar(&f.filters, "filter", nil,
+		"Key-value pair for exact CloudEvent attribute matching of the events sent to a broker, e.g. type=dev.knative.foo. "+
+			"To set multiple filters, use the flag multiple times.")
+	command.Flags().StringVarP(&f.output, "output", "o", "", "Output format. One of: json.")
+	command.Flags().StringVar(&f.receiverImage, "receiver-image", "",
+		"Image of the receiver pod, which has to run kn as entrypoint. Defaults to the kn release image of this version.")
+	command.Flags().DurationVar(&f.timeout, "timeout", 2*time.Minute, "Duration to wait for the receiver and its trigger or subscription to become ready.")
+	command.MarkFlagRequired("on")
+	return command
+}
+
+// receiverImage returns the given image, or the kn release image matching the version
+// of this client. Development builds have no matching release image.
+func receiverImage(image string) (string, error) {
+	if image != "" {
+		return image, nil
+	}
+	if !semver.IsValid(version.Version) {
+		return "", fmt.Errorf("no receiver image is released for kn version '%s', use --receiver-image to set one", version.Version)
+	}
+	return receiverImageRepository + ":" + version.Version, nil
+}
+
+// listener manages the temporary resources for receiving events
+type listener struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	errOut    io.Writer
+	cleanups  []func(ctx context.Context) error
+}
+
+// deployReceiver creates the receiver pod with a service and waits until it's ready
+func (l *listener) deployReceiver(ctx context.Context, image string, timeout time.Duration) error {
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "kn",
+		"app.kubernetes.io/component":  "event-listener",
+		"app.kubernetes.io/instance":   l.name,
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: l.name, Namespace: l.namespace, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt32(receiverPort)}},
+		},
+	}
+	if _, err := l.client.CoreV1().Services(l.namespace).Create(ctx, service, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("cannot create receiver service: %w", err)
+	}
+	l.cleanups = append(l.cleanups, func(ctx context.Context) error {
+		return l.client.CoreV1().Services(l.namespace).Delete(ctx, l.name, metav1.DeleteOptions{})
+	})
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: l.name, Namespace: l.namespace, Labels: labels},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:  "receiver",
+				Image: image,
+				Args:  []string{"event", "receive", "--port", fmt.Sprint(receiverPort)},
+				Ports: []corev1.ContainerPort{{ContainerPort: receiverPort}},
+				ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(receiverPort)},
+				}},
+				SecurityContext: knflags.DefaultStrictSecCon(),
+			}},
+		},
+	}
+	pod, err := l.client.CoreV1().Pods(l.namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot create receiver pod: %w", err)
+	}
+	l.cleanups = append(l.cleanups, func(ctx context.Context) error {
+		return l.client.CoreV1().Pods(l.namespace).Delete(ctx, l.name, metav1.DeleteOptions{})
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err = waitForPod(ctx, l.client, pod, func(pod *corev1.Pod) (bool, error) {
+		switch pod.Status.Phase {
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, fmt.Errorf("receiver pod '%s' terminated", pod.Name)
+		case corev1.PodRunning:
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == corev1.PodReady {
+					return condition.Status == corev1.ConditionTrue, nil
+				}
+			}
+		}
+		return false, nil
+	})
+	return err
+}
+
+// createTrigger subscribes the receiver to the broker with a temporary trigger
+func (l *listener) createTrigger(ctx context.Context, p *commands.KnParams, broker string, filters map[string]string, subscriber *duckv1.Destination, timeout time.Duration) error {
+	client, err := p.NewEventingClient(l.namespace)
+	if err != nil {
+		return err
+	}
+	trigger := clienteventingv1.NewTriggerBuilder(l.name).
+		Namespace(l.namespace).
+		Broker(broker).
+		Filters(filters).
+		Subscriber(subscriber).
+		Build()
+	if err := client.CreateTrigger(ctx, trigger); err != nil {
+		return fmt.Errorf("cannot create trigger for broker '%s': %w", broker, err)
+	}
+	l.cleanups = append(l.cleanups, func(ctx context.Context) error {
+		return client.DeleteTrigger(ctx, l.name)
+	})
+	return l.waitUntilReady(ctx, "trigger", timeout, func(ctx context.Context) (*apis.Condition, error) {
+		trigger, err := client.GetTrigger(ctx, l.name)
+		if err != nil {
+			return nil, err
+		}
+		return trigger.Status.GetCondition(apis.ConditionReady), nil
+	})
+}
+
+// createSubscription subscribes the receiver to the channel with a temporary subscription
+func (l *listener) createSubscription(ctx context.Context, p *commands.KnParams, channel string, subscriber *duckv1.Destination, timeout time.Duration) error {
+	client, err := p.NewMessagingClient(l.namespace)
+	if err != nil {
+		return err
+	}
+	subscriptions := client.SubscriptionsClient()
+	subscription := clientmessagingv1.NewSubscriptionBuilder(l.name).
+		Channel(&duckv1.KReference{Kind: "Channel", APIVersion: "messaging.knative.dev/v1", Name: channel}).
+		Subscriber(subscriber).
+		Build()
+	subscription.Namespace = l.namespace
+	if err := subscriptions.CreateSubscription(ctx, subscription); err != nil {
+		return fmt.Errorf("cannot create subscription for channel '%s': %w", channel, err)
+	}
+	l.cleanups = append(l.cleanups, func(ctx context.Context) error {
+		return subscriptions.DeleteSubscription(ctx, l.name)
+	})
+	return l.waitUntilReady(ctx, "subscription", timeout, func(ctx context.Context) (*apis.Condition, error) {
+		subscription, err := subscriptions.GetSubscription(ctx, l.name)
+		if err != nil {
+			return nil, err
+		}
+		return subscription.Status.GetCondition(apis.ConditionReady), nil
+	})
+}
+
+// waitUntilReady polls the Ready condition of the temporary trigger or subscription, so that
+// no events are missed once the user is told that the listener is running
+func (l *listener) waitUntilReady(ctx context.Context, kind string, timeout time.Duration, ready func(ctx context.Context) (*apis.Condition, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var last *apis.Condition
+	err := wait.PollUntilContextCancel(ctx, 500*time.Millisecond, true, func(ctx context.Context) (bool, error) {
+		var err error
+		if last, err = ready(ctx); err != nil {
+			return false, err
+		}
+		return last.IsTrue(), nil
+	})
+	if wait.Interrupted(err) {
+		if last != nil && last.Message != "" {
+			return fmt.Errorf("timeout while waiting for %s '%s' to become ready: %s", kind, l.name, last.Message)
+		}
+		return fmt.Errorf("timeout while waiting for %s '%s' to become ready: %w", kind, l.name, err)
+	}
+	return err
+}
+
+// cleanup removes the temporary resources in the reverse order of their creation
+func (l *listener) cleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for _, cleanup := range slices.Backward(l.cleanups) {
+		if err := cleanup(ctx); err != nil {
+			fmt.Fprintf(l.errOut, "Warning: cannot remove temporary resource '%s': %v\n", l.name, err)
+		}
+	}
+	l.cleanups = nil
+}
+
+// printEvents prints the events given as JSON lines, as written by 'kn event receive'.
+// Other lines are skipped.
+func printEvents(out io.Writer, in io.Reader, format string) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var event ce.Event
+		if json.Unmarshal(scanner.Bytes(), &event) != nil || event.Validate() != nil {
+			continue
+		}
+		if format == "json" {
+			fmt.Fprintln(out, scanner.Text())
+			continue
+		}
+		if err := printEvent(out, &event); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// printEvent writes the attributes, the extensions and the decoded data of the event
+func printEvent(w io.Writer, event *ce.Event) error {
+	dw := printers.NewPrefixWriter(w)
+	dw.WriteAttribute("Type", event.Type())
+	dw.WriteAttribute("ID", event.ID())
+	dw.WriteAttribute("Source", event.Source())
+	if event.Subject() != "" {
+		dw.WriteAttribute("Subject", event.Subject())
+	}
+	if !event.Time().IsZero() {
+		dw.WriteAttribute("Time", event.Time().Format(time.RFC3339Nano))
+	}
+	if event.DataContentType() != "" {
+		dw.WriteAttribute("Content Type", event.DataContentType())
+	}
+	if event.DataSchema() != "" {
+		dw.WriteAttribute("Data Schema", event.DataSchema())
+	}
+	extensions := event.Extensions()
+	if len(extensions) > 0 {
+		section := dw.WriteAttribute("Extensions", "")
+		for _, name := range slices.Sorted(maps.Keys(extensions)) {
+			section.WriteAttribute(name, fmt.Sprint(extensions[name]))
+		}
+	}
+	data := event.Data()
+	if len(data) > 0 {
+		dw.WriteAttribute("Data", "")
+	}
+	if err := dw.Flush(); err != nil {
+		return err
+	}
+	if len(data) > 0 {
+		for _, line := range strings.Split(strings.TrimRight(formatData(data), "\n"), "\n") {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+// formatData returns JSON data indented, text as is and binary data base64 encoded
+func formatData(data []byte) string {
+	var indented bytes.Buffer
+	if json.Indent(&indented, data, "", "  ") == nil {
+		return indented.String()
+	}
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	return "(base64) " + base64.StdEncoding.EncodeToString(data)
+}
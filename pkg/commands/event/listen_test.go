@@ -0,0 +1,253 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	eventingfake "knative.dev/eventing/pkg/client/clientset/versioned/fake"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/commands/version"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	knflags "knative.dev/client/pkg/flags"
+	clientmessagingv1 "knative.dev/client/pkg/messaging/v1"
+	"knative.dev/client/pkg/util"
+)
+
+const listenLogs = `Receiving events on port 8080
+{"specversion":"1.0","id":"1","source":"kn","type":"dev.example.ping","datacontenttype":"application/json","time":"2026-10-17T10:00:00Z","tenant":"acme","data":{"message":"hello"}}
+{"specversion":"1.0","id":"2","source":"kn","type":"dev.example.pong","datacontenttype":"text/plain","data":"first line\n\nthird line"}
+{"unrelated": true}
+`
+
+func TestPrintEvents(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NilError(t, printEvents(out, strings.NewReader(listenLogs), ""))
+	assert.Equal(t, out.String(), `Type:          dev.example.ping
+ID:            1
+Source:        kn
+Time:          2026-10-17T10:00:00Z
+Content Type:  application/json
+Extensions:    
+  tenant:      acme
+Data:          
+  {
+    "message": "hello"
+  }
+
+Type:          dev.example.pong
+ID:            2
+Source:        kn
+Content Type:  text/plain
+Data:          
+  first line
+  
+  third line
+
+`)
+
+	out.Reset()
+	assert.NilError(t, printEvents(out, strings.NewReader(listenLogs), "json"))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, len(lines), 2)
+	assert.Assert(t, strings.HasPrefix(lines[0], `{"specversion":"1.0","id":"1"`))
+}
+
+func TestFormatData(t *testing.T) {
+	assert.Equal(t, formatData([]byte(`{"a":1}`)), "{\n  \"a\": 1\n}")
+	assert.Equal(t, formatData([]byte("hello")), "hello")
+	assert.Equal(t, formatData([]byte{0xff, 0xfe}), "(base64) //4=")
+}
+
+func TestEventListenBroker(t *testing.T) {
+	kubeClient := newListenerKubeClient()
+	eventingClient := eventingfake.NewSimpleClientset()
+	eventingClient.PrependReactor("get", "triggers", readyReactor(&eventingv1.Trigger{}))
+	p := newTestParams(nil, kubeClient)
+	p.NewEventingClient = func(namespace string) (clienteventingv1.KnEventingClient, error) {
+		return clienteventingv1.NewKnEventingClient(eventingClient.EventingV1(), namespace), nil
+	}
+
+	// the fake logs of the receiver end immediately, as if the pod stopped
+	out, err := executeEventCommandWithParams(p, "listen", "--on", "broker:default", "--filter", "type=dev.example.ping", "--receiver-image", "kn")
+	assert.ErrorContains(t, err, "stopped unexpectedly")
+	assert.Assert(t, util.ContainsAll(out, "Listening for events sent to 'broker:default'"))
+
+	var trigger *eventingv1.Trigger
+	for _, action := range eventingClient.Actions() {
+		if action.GetVerb() == "create" {
+			trigger = action.(clienttesting.CreateAction).GetObject().(*eventingv1.Trigger)
+		}
+	}
+	assert.Assert(t, trigger != nil)
+	assert.Equal(t, trigger.Spec.Broker, "default")
+	assert.DeepEqual(t, trigger.Spec.Filter.Attributes, eventingv1.TriggerFilterAttributes{"type": "dev.example.ping"})
+	assert.Equal(t, trigger.Spec.Subscriber.Ref.Kind, "Service")
+	assert.Equal(t, trigger.Spec.Subscriber.Ref.Name, trigger.Name)
+	assertCleanedUp(t, kubeClient, trigger.Name)
+	triggers, err := eventingClient.EventingV1().Triggers("default").List(context.Background(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(triggers.Items), 0)
+}
+
+func TestEventListenChannel(t *testing.T) {
+	kubeClient := newListenerKubeClient()
+	messagingClient := eventingfake.NewSimpleClientset()
+	messagingClient.PrependReactor("get", "subscriptions", readyReactor(&messagingv1.Subscription{}))
+	p := newTestParams(nil, kubeClient)
+	p.NewMessagingClient = func(namespace string) (clientmessagingv1.KnMessagingClient, error) {
+		return clientmessagingv1.NewKnMessagingClient(messagingClient.MessagingV1(), namespace), nil
+	}
+
+	_, err := executeEventCommandWithParams(p, "listen", "--on", "channel:pipe", "-o", "json", "--receiver-image", "kn")
+	assert.ErrorContains(t, err, "stopped unexpectedly")
+
+	var subscription *messagingv1.Subscription
+	for _, action := range messagingClient.Actions() {
+		if action.GetVerb() == "create" {
+			subscription = action.(clienttesting.CreateAction).GetObject().(*messagingv1.Subscription)
+		}
+	}
+	assert.Assert(t, subscription != nil)
+	assert.Equal(t, subscription.Spec.Channel.Name, "pipe")
+	assert.Equal(t, subscription.Spec.Subscriber.Ref.Name, subscription.Name)
+	assertCleanedUp(t, kubeClient, subscription.Name)
+}
+
+func TestEventListenErrors(t *testing.T) {
+	p := newTestParams(nil, nil)
+	_, err := executeEventCommandWithParams(p, "listen")
+	assert.ErrorContains(t, err, "required flag(s) \"on\" not set")
+	_, err = executeEventCommandWithParams(p, "listen", "--on", "ksvc:foo")
+	assert.ErrorContains(t, err, "--on has to reference a broker or a channel")
+	_, err = executeEventCommandWithParams(p, "listen", "--on", "http://localhost:8080")
+	assert.ErrorContains(t, err, "--on has to reference a broker or a channel")
+	_, err = executeEventCommandWithParams(p, "listen", "--on", "channel:pipe", "--filter", "type=foo")
+	assert.ErrorContains(t, err, "--filter can only be used when listening on a broker")
+	_, err = executeEventCommandWithParams(p, "listen", "--on", "broker:default", "--filter", "type")
+	assert.ErrorContains(t, err, "Invalid --filter")
+	_, err = executeEventCommandWithParams(p, "listen", "--on", "broker:default", "-o", "yaml")
+	assert.ErrorContains(t, err, "invalid value for output flag")
+	_, err = executeEventCommandWithParams(p, "listen", "--on", "broker:default")
+	assert.ErrorContains(t, err, "use --receiver-image")
+
+	// the receiver pod terminates before getting ready
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("get", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}}, nil
+	})
+	_, err = executeEventCommandWithParams(newTestParams(nil, kubeClient), "listen", "--on", "broker:default", "--receiver-image", "kn")
+	assert.ErrorContains(t, err, "terminated")
+	pods, err := kubeClient.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pods.Items), 0)
+}
+
+func TestEventListenNotReady(t *testing.T) {
+	kubeClient := newListenerKubeClient()
+	eventingClient := eventingfake.NewSimpleClientset()
+	p := newTestParams(nil, kubeClient)
+	p.NewEventingClient = func(namespace string) (clienteventingv1.KnEventingClient, error) {
+		return clienteventingv1.NewKnEventingClient(eventingClient.EventingV1(), namespace), nil
+	}
+
+	// the trigger created by the fake client never gets ready
+	out, err := executeEventCommandWithParams(p, "listen", "--on", "broker:default", "--receiver-image", "kn", "--timeout", "10ms")
+	assert.ErrorContains(t, err, "timeout while waiting for trigger")
+	assert.Assert(t, util.ContainsNone(out, "Listening for events"))
+	triggers, err := eventingClient.EventingV1().Triggers("default").List(context.Background(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(triggers.Items), 0)
+}
+
+func TestReceiverImage(t *testing.T) {
+	defer func(v string) { version.Version = v }(version.Version)
+
+	version.Version = "v1.21.0"
+	image, err := receiverImage("")
+	assert.NilError(t, err)
+	assert.Equal(t, image, "gcr.io/knative-releases/knative.dev/client/cmd/kn:v1.21.0")
+	image, err = receiverImage("example.com/kn:dev")
+	assert.NilError(t, err)
+	assert.Equal(t, image, "example.com/kn:dev")
+
+	version.Version = ""
+	_, err = receiverImage("")
+	assert.ErrorContains(t, err, "use --receiver-image")
+}
+
+// readyReactor returns the requested trigger or subscription with a true Ready condition
+func readyReactor[T interface {
+	runtime.Object
+	metav1.Object
+	duckv1.KRShaped
+}](obj T) clienttesting.ReactionFunc {
+	return func(action clienttesting.Action) (bool, runtime.Object, error) {
+		obj.SetName(action.(clienttesting.GetAction).GetName())
+		obj.SetNamespace(action.GetNamespace())
+		obj.GetStatus().SetConditions(apis.Conditions{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}})
+		return true, obj, nil
+	}
+}
+
+// newListenerKubeClient returns a client whose pods are running and ready
+func newListenerKubeClient() *fake.Clientset {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("get", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		get, ok := action.(clienttesting.GetAction)
+		if !ok {
+			// the logs of the pod
+			return false, nil, nil
+		}
+		return true, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: get.GetName(), Namespace: "default"},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}, nil
+	})
+	return kubeClient
+}
+
+func assertCleanedUp(t *testing.T, kubeClient *fake.Clientset, name string) {
+	var created, deleted []string
+	for _, action := range kubeClient.Actions() {
+		switch action.GetVerb() {
+		case "create":
+			created = append(created, action.GetResource().Resource)
+		case "delete":
+			assert.Equal(t, action.(clienttesting.DeleteAction).GetName(), name)
+			deleted = append(deleted, action.GetResource().Resource)
+		}
+	}
+	assert.DeepEqual(t, created, []string{"services", "pods"})
+	pod := kubeClient.Actions()[1].(clienttesting.CreateAction).GetObject().(*corev1.Pod)
+	assert.DeepEqual(t, pod.Spec.Containers[0].SecurityContext, knflags.DefaultStrictSecCon())
+	assert.DeepEqual(t, deleted, []string{"pods", "services"})
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+)
+
+// NewEventReceiveCommand represents the hidden 'kn event receive' command, which is run
+// by the receiver pod of 'kn event listen'
+func NewEventReceiveCommand(p *commands.KnParams) *cobra.Command {
+	var port int
+	command := &cobra.Command{
+		Use:    "receive",
+		Short:  "Receive CloudEvents and print them as JSON, one event per line",
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn event receive' doesn't accept arguments")
+			}
+			server := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: newReceiveHandler(cmd.OutOrStdout())}
+			go func() {
+				<-cmd.Context().Done()
+				server.Close()
+			}()
+			if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+	command.Flags().IntVar(&port, "port", 8080, "Port to receive the events on.")
+	return command
+}
+
+// newReceiveHandler returns a handler accepting CloudEvents in binary or structured content
+// mode, which are written to out as JSON lines
+func newReceiveHandler(out io.Writer) http.Handler {
+	var mutex sync.Mutex
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		event, err := cehttp.NewEventFromHTTPRequest(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		line, err := json.Marshal(event)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		fmt.Fprintln(out, string(line))
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2/event"
+	"gotest.tools/v3/assert"
+)
+
+func TestReceiveHandler(t *testing.T) {
+	out := new(bytes.Buffer)
+	server := httptest.NewServer(newReceiveHandler(out))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"message": "hello"}`))
+	assert.NilError(t, err)
+	req.Header.Set("Ce-Specversion", "1.0")
+	req.Header.Set("Ce-Id", "1")
+	req.Header.Set("Ce-Type", "dev.example.ping")
+	req.Header.Set("Ce-Source", "test")
+	req.Header.Set("Ce-Tenant", "acme")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NilError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusAccepted)
+
+	var event ce.Event
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &event))
+	assert.Equal(t, event.ID(), "1")
+	assert.Equal(t, event.Type(), "dev.example.ping")
+	assert.Equal(t, event.Extensions()["tenant"], "acme")
+	assert.Equal(t, string(event.Data()), `{"message":"hello"}`)
+	assert.Equal(t, strings.Count(out.String(), "\n"), 1)
+
+	resp, err = http.Post(server.URL, "text/plain", strings.NewReader("no event"))
+	assert.NilError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusBadRequest)
+}
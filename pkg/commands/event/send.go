@@ -0,0 +1,381 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	ce "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/commands/flags"
+	knflags "knative.dev/client/pkg/flags"
+	"knative.dev/client/pkg/flags/sink"
+	"knative.dev/client/pkg/util"
+)
+
+// defaultSenderImage is the image of the pod sending events to addresses which are only
+// reachable from within the cluster
+const defaultSenderImage = "docker.io/curlimages/curl:8.10.1"
+
+// defaultSenderUser is the ID of the unprivileged user of the default sender image
+const defaultSenderUser = 100
+
+// lookupHost checks whether the host of an address can be resolved from here, which is
+// not the case for cluster local addresses when running outside of the cluster
+var lookupHost = net.DefaultResolver.LookupHost
+
+var sendExample = `
+  # Send an event of type 'dev.example.ping' to the broker 'default'
+  kn event send --to broker:default --type dev.example.ping --source kn --data '{"message": "hello"}'
+
+  # Send an event with an extension attribute in structured content mode to the Knative service 'receiver'
+  kn event send --to ksvc:receiver --type dev.example.ping --extension tenant=acme --structured
+
+  # Send an event to a URL
+  kn event send --to http://localhost:8080 --type dev.example.ping --data hello`
+
+// sendFlags are the flags for the event to send and how to send it
+type sendFlags struct {
+	to          flags.SinkFlags
+	id          string
+	eventType   string
+	source      string
+	data        string
+	contentType string
+	extensions  []string
+	binary      bool
+	structured  bool
+	inCluster   bool
+	senderImage string
+	timeout     time.Duration
+}
+
+// NewEventSendCommand represents 'kn event send' command
+func NewEventSendCommand(p *commands.KnParams) *cobra.Command {
+	var f sendFlags
+
+	command := &cobra.Command{
+		Use:   "send --to TARGET --type TYPE",
+		Short: "Send a CloudEvent",
+		Long: `Send a CloudEvent to a broker, a channel, a service or a URL
+
+The event is sent directly if the host of the target's address can be resolved from
+here and accepts connections. Otherwise, like for the cluster local address of a broker,
+a short-lived pod is started in the namespace of the target which sends the event from
+within the cluster.`,
+		Example: sendExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn event send' doesn't accept arguments")
+			}
+			if f.binary && f.structured {
+				return errors.New("only one of --binary or --structured can be given")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			event, err := f.newEvent()
+			if err != nil {
+				return err
+			}
+			ref, err := f.to.Parse(namespace)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), f.timeout)
+			defer cancel()
+			target, err := resolveAddress(ctx, p, ref)
+			if err != nil {
+				return err
+			}
+			inCluster := f.inCluster || !isResolvable(ctx, target)
+			if !inCluster {
+				req, err2 := f.newRequest(ctx, event, target)
+				if err2 != nil {
+					return err2
+				}
+				err = sendDirect(req)
+				// a resolvable host may still not be routable from here, e.g. a cluster
+				// local address resolved by a VPN, so the event is sent from the cluster
+				inCluster = isDialError(err)
+			}
+			if inCluster {
+				req, err2 := f.newRequest(ctx, event, target)
+				if err2 != nil {
+					return err2
+				}
+				kubeClient, err2 := p.NewKubeClient()
+				if err2 != nil {
+					return err2
+				}
+				targetNamespace := namespace
+				if ref.KubeReference != nil {
+					targetNamespace = ref.Namespace
+				}
+				err = sendFromPod(ctx, kubeClient, targetNamespace, f.senderImage, req)
+			}
+			if err != nil {
+				return fmt.Errorf("cannot send event to '%s': %w", ref.AsText(namespace), err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Event '%s' of type '%s' sent to '%s'.\n", event.ID(), event.Type(), ref.AsText(namespace))
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	f.to.AddWithFlagName(command, "to", "")
+	command.Flags().StringVar(&f.eventType, "type", "", "Type of the event, e.g. dev.example.ping.")
+	command.Flags().StringVar(&f.source, "source", "kn", "Source of the event.")
+	command.Flags().StringVar(&f.id, "id", "", "ID of the event. A random ID is generated if not given.")
+	command.Flags().StringVar(&f.data, "data", "", "Data of the event.")
+	command.Flags().StringVar(&f.contentType, "content-type", "",
+		"Content type of the data. Defaults to 'application/json' if the data is valid JSON, 'text/plain' otherwise.")
+	command.Flags().StringSliceVar(&f.extensions, "extension", nil,
+		"Extension attribute of the event given as key-value pair, e.g. --extension tenant=acme. "+
+			"To set multiple extensions, use the flag multiple times.")
+	command.Flags().BoolVar(&f.binary, "binary", false, "Send the event in binary content mode, with the attributes as HTTP headers. This is the default.")
+	command.Flags().BoolVar(&f.structured, "structured", false, "Send the event in structured content mode, as a JSON document.")
+	command.Flags().BoolVar(&f.inCluster, "in-cluster", false, "Always send the event from a pod within the cluster, even if the target can be reached directly.")
+	command.Flags().StringVar(&f.senderImage, "sender-image", defaultSenderImage, "Image of the pod sending the event from within the cluster, which has to provide curl.")
+	command.Flags().DurationVar(&f.timeout, "timeout", time.Minute, "Duration to wait for the event to be sent.")
+	command.MarkFlagRequired("to")
+	command.MarkFlagRequired("type")
+	return command
+}
+
+// newEvent creates the event per the flags and validates it
+func (f *sendFlags) newEvent() (ce.Event, error) {
+	event := ce.New()
+	id := f.id
+	if id == "" {
+		id = string(uuid.NewUUID())
+	}
+	event.SetID(id)
+	event.SetType(f.eventType)
+	event.SetSource(f.source)
+	event.SetTime(time.Now())
+
+	extensions, err := util.MapFromArray(f.extensions, "=")
+	if err != nil {
+		return event, fmt.Errorf("Invalid --extension: %w", err)
+	}
+	for name, value := range extensions {
+		event.SetExtension(name, value)
+	}
+	if f.data != "" {
+		contentType := f.contentType
+		if contentType == "" {
+			contentType = "text/plain"
+			if json.Valid([]byte(f.data)) {
+				contentType = ce.ApplicationJSON
+			}
+		}
+		if err := event.SetData(contentType, []byte(f.data)); err != nil {
+			return event, err
+		}
+	}
+	if err := event.Validate(); err != nil {
+		return event, fmt.Errorf("invalid event: %w", err)
+	}
+	return event, nil
+}
+
+// newRequest creates the HTTP request delivering the event in binary or structured content mode
+func (f *sendFlags) newRequest(ctx context.Context, event ce.Event, target *apis.URL) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	encodingCtx := binding.WithForceBinary(ctx)
+	if f.structured {
+		encodingCtx = binding.WithForceStructured(ctx)
+	}
+	if err := cehttp.WriteRequest(encodingCtx, binding.ToMessage(&event), req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// resolveAddress returns the address of the target, which is looked up in the status of
+// addressable resources. Kubernetes services are addressed by their cluster local name.
+func resolveAddress(ctx context.Context, p *commands.KnParams, ref *sink.Reference) (*apis.URL, error) {
+	if ref.Type() == sink.TypeURL {
+		return ref.URL, nil
+	}
+	dynamicClient, err := p.NewDynamicClient(ref.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := ref.Resolve(ctx, dynamicClient)
+	if err != nil {
+		return nil, err
+	}
+	if ref.GVR.Group == "" && ref.GVR.Resource == "services" {
+		return apis.HTTP(fmt.Sprintf("%s.%s.svc.cluster.local", ref.Name, ref.Namespace)), nil
+	}
+	obj, err := dynamicClient.RawClient().Resource(ref.GVR).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	address, _, _ := unstructured.NestedString(obj.Object, "status", "address", "url")
+	if address == "" {
+		return nil, fmt.Errorf("%s '%s' in namespace '%s' doesn't have an address yet", destination.Ref.Kind, ref.Name, ref.Namespace)
+	}
+	return apis.ParseURL(address)
+}
+
+// isResolvable returns true if the host of the address can be resolved. This is a heuristic
+// for whether the address can be reached from here: cluster local addresses usually can't be
+// resolved outside of the cluster.
+func isResolvable(ctx context.Context, target *apis.URL) bool {
+	_, err := lookupHost(ctx, target.URL().Hostname())
+	return err == nil
+}
+
+// isDialError returns true if the error is caused by failing to connect, in which case
+// nothing has been sent yet
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// sendDirect sends the request and checks that the event has been accepted
+func sendDirect(req *http.Request) error {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("event not accepted: %s %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// sendFromPod sends the request with curl from a short-lived pod in the given namespace,
+// which is deleted afterwards
+func sendFromPod(ctx context.Context, client kubernetes.Interface, namespace, image string, req *http.Request) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return err
+		}
+	}
+	args := []string{"--silent", "--show-error", "--fail", "-X", http.MethodPost}
+	if deadline, ok := ctx.Deadline(); ok {
+		args = append(args, "--max-time", strconv.Itoa(max(1, int(time.Until(deadline).Seconds()))))
+	}
+	for _, name := range slices.Sorted(maps.Keys(req.Header)) {
+		for _, value := range req.Header.Values(name) {
+			args = append(args, "-H", name+": "+value)
+		}
+	}
+	args = append(args, "--data-binary", string(body), req.URL.String())
+
+	securityContext := knflags.DefaultStrictSecCon()
+	if image == defaultSenderImage {
+		// the curl image sets its user by name, which can't be verified to be non-root
+		securityContext.RunAsUser = ptr.To[int64](defaultSenderUser)
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "kn-event-sender-",
+			Namespace:    namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "kn",
+				"app.kubernetes.io/component":  "event-sender",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:            "sender",
+				Image:           image,
+				Command:         []string{"curl"},
+				Args:            args,
+				SecurityContext: securityContext,
+			}},
+		},
+	}
+	pod, err := client.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot create sender pod: %w", err)
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		_ = client.CoreV1().Pods(namespace).Delete(context.WithoutCancel(ctx), pod.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	}()
+
+	pod, err = waitForPod(ctx, client, pod, func(pod *corev1.Pod) (bool, error) {
+		return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed, nil
+	})
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase == corev1.PodFailed {
+		logs, _ := client.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{}).DoRaw(context.WithoutCancel(ctx))
+		return fmt.Errorf("sender pod '%s' failed: %s", pod.Name, bytes.TrimSpace(logs))
+	}
+	return nil
+}
+
+// waitForPod polls the pod until the condition is met, or the context is done
+func waitForPod(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod, condition func(*corev1.Pod) (bool, error)) (*corev1.Pod, error) {
+	current := pod
+	err := wait.PollUntilContextCancel(ctx, 500*time.Millisecond, true, func(ctx context.Context) (bool, error) {
+		var err error
+		if current, err = client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{}); err != nil {
+			return false, err
+		}
+		return condition(current)
+	})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("pod '%s' has been removed", pod.Name)
+	case wait.Interrupted(err):
+		return nil, fmt.Errorf("timeout while waiting for pod '%s': %w", pod.Name, err)
+	case err != nil:
+		return nil, err
+	}
+	return current, nil
+}
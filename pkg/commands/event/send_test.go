@@ -0,0 +1,205 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	"knative.dev/client/pkg/util"
+)
+
+// receiver is a local stand-in for a sink, recording the events it receives
+type receiver struct {
+	*httptest.Server
+	events  []*ce.Event
+	headers []http.Header
+	status  int
+}
+
+func newReceiver(t *testing.T) *receiver {
+	r := &receiver{status: http.StatusAccepted}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		event, err := cehttp.NewEventFromHTTPRequest(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.events = append(r.events, event)
+		r.headers = append(r.headers, req.Header)
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func TestEventSendURL(t *testing.T) {
+	r := newReceiver(t)
+
+	out, err := executeEventCommand(nil, nil, "send", "--to", r.URL, "--type", "dev.example.ping", "--id", "1",
+		"--data", `{"message": "hello"}`, "--extension", "tenant=acme")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "Event '1' of type 'dev.example.ping' sent to '"+r.URL+"'.\n")
+	assert.Equal(t, len(r.events), 1)
+	event := r.events[0]
+	assert.Equal(t, event.Type(), "dev.example.ping")
+	assert.Equal(t, event.Source(), "kn")
+	assert.Equal(t, event.DataContentType(), "application/json")
+	assert.Equal(t, string(event.Data()), `{"message": "hello"}`)
+	assert.Equal(t, event.Extensions()["tenant"], "acme")
+	// binary content mode is the default
+	assert.Equal(t, r.headers[0].Get("Ce-Type"), "dev.example.ping")
+
+	_, err = executeEventCommand(nil, nil, "send", "--to", r.URL, "--type", "dev.example.ping", "--source", "test",
+		"--data", "hello", "--structured")
+	assert.NilError(t, err)
+	assert.Equal(t, len(r.events), 2)
+	assert.Equal(t, r.events[1].Source(), "test")
+	assert.Equal(t, r.events[1].DataContentType(), "text/plain")
+	assert.Equal(t, r.headers[1].Get("Content-Type"), "application/cloudevents+json")
+	assert.Equal(t, r.headers[1].Get("Ce-Type"), "")
+
+	r.status = http.StatusBadRequest
+	_, err = executeEventCommand(nil, nil, "send", "--to", r.URL, "--type", "dev.example.ping")
+	assert.ErrorContains(t, err, "event not accepted: 400 Bad Request")
+}
+
+func TestEventSendBroker(t *testing.T) {
+	r := newReceiver(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", newBroker("default", r.URL), newBroker("pending", ""))
+
+	out, err := executeEventCommand(dynamicClient, nil, "send", "--to", "broker:default", "--type", "dev.example.ping", "--id", "1")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "Event '1' of type 'dev.example.ping' sent to 'broker:default'.\n")
+	assert.Equal(t, len(r.events), 1)
+
+	_, err = executeEventCommand(dynamicClient, nil, "send", "--to", "broker:pending", "--type", "dev.example.ping")
+	assert.ErrorContains(t, err, "Broker 'pending' in namespace 'default' doesn't have an address yet")
+
+	_, err = executeEventCommand(dynamicClient, nil, "send", "--to", "broker:missing", "--type", "dev.example.ping")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestEventSendFromPod(t *testing.T) {
+	original := lookupHost
+	lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		if strings.HasSuffix(host, ".svc.cluster.local") {
+			return nil, errors.New("no such host")
+		}
+		return original(ctx, host)
+	}
+	t.Cleanup(func() { lookupHost = original })
+
+	clusterLocal := "http://broker-ingress.knative-eventing.svc.cluster.local/default/default"
+	// an address which can be resolved, but doesn't accept connections
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	for _, tc := range []struct {
+		name    string
+		address string
+		phase   corev1.PodPhase
+		err     string
+	}{
+		{name: "succeeded", address: clusterLocal, phase: corev1.PodSucceeded},
+		{name: "failed", address: clusterLocal, phase: corev1.PodFailed, err: "sender pod 'kn-event-sender-1' failed: fake logs"},
+		{name: "not connectable", address: closed.URL, phase: corev1.PodSucceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", newBroker("default", tc.address))
+			kubeClient := fake.NewSimpleClientset()
+			var created *corev1.Pod
+			kubeClient.PrependReactor("create", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				created = action.(clienttesting.CreateAction).GetObject().(*corev1.Pod).DeepCopy()
+				created.Name = "kn-event-sender-1"
+				return true, created, nil
+			})
+			kubeClient.PrependReactor("get", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				pod := created.DeepCopy()
+				pod.Status.Phase = tc.phase
+				return true, pod, nil
+			})
+			var deleted string
+			kubeClient.PrependReactor("delete", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				deleted = action.(clienttesting.DeleteAction).GetName()
+				return true, nil, nil
+			})
+
+			out, err := executeEventCommand(dynamicClient, kubeClient, "send", "--to", "broker:default", "--type", "dev.example.ping",
+				"--id", "1", "--data", "hello")
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+			} else {
+				assert.NilError(t, err)
+				assert.Assert(t, util.ContainsAll(out, "Event '1'", "sent to 'broker:default'"))
+			}
+			assert.Equal(t, created.Namespace, "default")
+			assert.Equal(t, created.Spec.RestartPolicy, corev1.RestartPolicyNever)
+			args := created.Spec.Containers[0].Args
+			assert.Equal(t, args[len(args)-1], tc.address)
+			assert.Equal(t, args[len(args)-2], "hello")
+			assert.Assert(t, slices.Contains(args, "Ce-Type: dev.example.ping"))
+			securityContext := created.Spec.Containers[0].SecurityContext
+			assert.Equal(t, *securityContext.RunAsNonRoot, true)
+			assert.Equal(t, *securityContext.RunAsUser, int64(defaultSenderUser))
+			assert.Equal(t, *securityContext.AllowPrivilegeEscalation, false)
+			assert.DeepEqual(t, securityContext.Capabilities.Drop, []corev1.Capability{"ALL"})
+			assert.Equal(t, securityContext.SeccompProfile.Type, corev1.SeccompProfileTypeRuntimeDefault)
+			assert.Equal(t, deleted, "kn-event-sender-1")
+		})
+	}
+}
+
+func TestEventSendErrors(t *testing.T) {
+	_, err := executeEventCommand(nil, nil, "send", "--to", "http://localhost", "--binary", "--structured", "--type", "foo")
+	assert.ErrorContains(t, err, "only one of --binary or --structured")
+	_, err = executeEventCommand(nil, nil, "send", "--to", "http://localhost")
+	assert.ErrorContains(t, err, "required flag(s) \"type\" not set")
+	_, err = executeEventCommand(nil, nil, "send", "--to", "http://localhost", "--type", "foo", "--extension", "tenant")
+	assert.ErrorContains(t, err, "Invalid --extension")
+	_, err = executeEventCommand(nil, nil, "send", "--to", "http://localhost", "--type", "foo", "--extension", "Bad-Name=x")
+	assert.ErrorContains(t, err, "invalid event")
+	_, err = executeEventCommand(nil, nil, "send", "--to", "http://localhost", "--type", "foo", "bar")
+	assert.ErrorContains(t, err, "doesn't accept arguments")
+}
+
+func newBroker(name, address string) *eventingv1.Broker {
+	broker := &eventingv1.Broker{
+		TypeMeta:   metav1.TypeMeta{Kind: "Broker", APIVersion: "eventing.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+	}
+	if address != "" {
+		url, _ := apis.ParseURL(address)
+		broker.Status.Address = &duckv1.Addressable{URL: url}
+	}
+	return broker
+}
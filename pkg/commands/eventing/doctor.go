@@ -0,0 +1,212 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/flags/sink"
+)
+
+// Reasons of findings
+const (
+	reasonBrokerNotFound            = "BrokerNotFound"
+	reasonChannelNotFound           = "ChannelNotFound"
+	reasonDestinationNotFound       = "DestinationNotFound"
+	reasonDestinationNotReady       = "DestinationNotReady"
+	reasonDestinationNotAddressable = "DestinationNotAddressable"
+)
+
+// explanations of the reasons, printed along with the findings
+var explanations = map[string]string{
+	reasonBrokerNotFound:            "The trigger refers to a broker which doesn't exist, so it doesn't receive any events.",
+	reasonChannelNotFound:           "The subscription refers to a channel which doesn't exist, so it doesn't receive any events.",
+	reasonDestinationNotFound:       "The destination refers to a resource which doesn't exist, so events sent to it are lost.",
+	reasonDestinationNotReady:       "The resource the destination refers to isn't ready, so events sent to it may not be delivered.",
+	reasonDestinationNotAddressable: "The resource the destination refers to has no address, so events can't be sent to it.",
+}
+
+var doctorExample = `
+  # Check the references between the eventing resources of the current namespace
+  kn eventing doctor
+
+  # Check namespace 'shop' in a CI pipeline, which fails if problems are found
+  kn eventing doctor -n shop -o json`
+
+// finding is a problem with a reference of an eventing resource
+type finding struct {
+	// Reason categorizes the finding, like DestinationNotFound
+	Reason string `json:"reason"`
+	// Resource is the kind and name of the resource holding the reference, like Trigger foo
+	Resource string `json:"resource"`
+	// Field is the field holding the reference, like spec.subscriber
+	Field string `json:"field"`
+	// Target is the kind and name of the referenced resource, or its URL
+	Target string `json:"target"`
+	// Message is the message of the ready condition of the referenced resource, if any
+	Message string `json:"message,omitempty"`
+}
+
+// checkReport holds the findings of checking the references of a namespace
+type checkReport struct {
+	Namespace string    `json:"namespace"`
+	Checked   int       `json:"checked"`
+	Findings  []finding `json:"findings"`
+}
+
+// NewEventingDoctorCommand creates a new command for checking the references between eventing resources
+func NewEventingDoctorCommand(p *commands.KnParams) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the references between eventing resources for problems",
+		Long: `Check the references between eventing resources for problems
+
+The sinks of sources, the subscribers and replies of triggers and subscriptions
+and the dead letter sinks of brokers, channels, triggers and subscriptions are
+checked to refer to resources which exist, are ready and have an address. Triggers
+and subscriptions are checked to refer to existing brokers and channels.
+
+For the use in CI pipelines, the command exits with 0 if no problems have been found,
+with 1 if problems have been found and with 2 if the check itself failed.`,
+		Example: doctorExample,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				err = knerrors.WithDefaultExitCode(checkErrorExitCode, err)
+			}()
+			if len(args) != 0 {
+				return fmt.Errorf("'kn eventing doctor' doesn't accept arguments")
+			}
+			switch output {
+			case "", "json":
+			default:
+				return fmt.Errorf("invalid value for output flag, choose 'json'")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := loadTopology(cmd.Context(), p, namespace)
+			if err != nil {
+				return err
+			}
+			report := checkTopology(t)
+
+			out := cmd.OutOrStdout()
+			if output == "json" {
+				b, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, string(b))
+			} else {
+				report.write(out)
+			}
+			if len(report.Findings) > 0 {
+				// the findings have been reported already
+				return knerrors.NewExitCodeError(findingsExitCode, "")
+			}
+			return nil
+		},
+	}
+	command.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return knerrors.WithDefaultExitCode(checkErrorExitCode, err)
+	})
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json.")
+	return command
+}
+
+// Exit codes of the doctor command, which tell found problems apart from a failed check
+const (
+	findingsExitCode   = 1
+	checkErrorExitCode = 2
+)
+
+// checkTopology checks every reference between the resources of the topology
+func checkTopology(t *topology) *checkReport {
+	report := &checkReport{Namespace: t.namespace, Findings: []finding{}}
+	for _, e := range t.edges {
+		from, to := t.index[e.From], t.index[e.To]
+		report.Checked++
+		switch e.Field {
+		case "spec.broker":
+			// events flow from the broker to the trigger referencing it
+			if from.Missing {
+				report.add(reasonBrokerNotFound, to, e.Field, from, "")
+			}
+		case "spec.channel":
+			if from.Missing {
+				report.add(reasonChannelNotFound, to, e.Field, from, "")
+			}
+		default:
+			checkDestination(report, e, from, to)
+		}
+	}
+	return report
+}
+
+// checkDestination checks that the resource a destination refers to exists, is ready
+// and has an address. URLs can't be checked, and Kubernetes services are always addressable.
+func checkDestination(report *checkReport, e edge, from, to *node) {
+	if to.Ref != nil && to.Ref.Type() == sink.TypeURL {
+		return
+	}
+	switch {
+	case to.Missing:
+		report.add(reasonDestinationNotFound, from, e.Field, to, "")
+	case to.Ready != nil && !to.Ready.IsTrue():
+		report.add(reasonDestinationNotReady, from, e.Field, to, to.Ready.Message)
+	case to.Address == nil && !isKubernetesService(to):
+		report.add(reasonDestinationNotAddressable, from, e.Field, to, "")
+	}
+}
+
+func isKubernetesService(n *node) bool {
+	return n.Ref != nil && n.Ref.KubeReference != nil && n.Ref.GVR == sink.DefaultMappings["service"]
+}
+
+func (r *checkReport) add(reason string, resource *node, field string, target *node, message string) {
+	r.Findings = append(r.Findings, finding{
+		Reason:   reason,
+		Resource: resource.Label(),
+		Field:    field,
+		Target:   target.Label(),
+		Message:  message,
+	})
+}
+
+// write prints the findings along with an explanation of each
+func (r *checkReport) write(out io.Writer) {
+	if len(r.Findings) == 0 {
+		fmt.Fprintf(out, "No problems found in %d reference(s) of namespace '%s'.\n", r.Checked, r.Namespace)
+		return
+	}
+	fmt.Fprintf(out, "Found %d problem(s) in %d reference(s) of namespace '%s':\n", len(r.Findings), r.Checked, r.Namespace)
+	for _, finding := range r.Findings {
+		fmt.Fprintf(out, "\n  %s: %s, %s\n", finding.Reason, finding.Resource, finding.Field)
+		fmt.Fprintf(out, "    %s\n", explanations[finding.Reason])
+		fmt.Fprintf(out, "    Refers to: %s\n", finding.Target)
+		if finding.Message != "" {
+			fmt.Fprintf(out, "    Message: %s\n", finding.Message)
+		}
+	}
+}
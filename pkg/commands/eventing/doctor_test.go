@@ -0,0 +1,139 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/util"
+)
+
+func TestEventingDoctorHealthy(t *testing.T) {
+	p := newTestParams([]runtime.Object{
+		newReadyBroker("default", nil),
+		newTrigger("ping", "default", *ksvc("display"), nil),
+	}, append(newPingSource("heartbeat", map[string]interface{}{
+		"ref": map[string]interface{}{"apiVersion": "eventing.knative.dev/v1", "kind": "Broker", "name": "default"},
+	}), newKnativeService("display", corev1.ConditionTrue, ""))...)
+
+	output, err := executeEventingCommand(p, "doctor")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "No problems found in 3 reference(s) of namespace 'default'.\n")
+}
+
+func TestEventingDoctorFindings(t *testing.T) {
+	output, err := executeEventingCommand(newDoctorParams(), "doctor")
+	assertExitCode(t, err, 1)
+	assert.Assert(t, util.ContainsAll(output,
+		"Found 5 problem(s) in 8 reference(s) of namespace 'default':",
+		"DestinationNotFound: Broker default, spec.delivery.deadLetterSink", "Refers to: Service dls",
+		"BrokerNotFound: Trigger orphan, spec.broker", "Refers to: Broker gone",
+		"DestinationNotReady: Trigger ping, spec.subscriber", "Refers to: Service display", "Message: Revision failed",
+		"ChannelNotFound: Subscription tap, spec.channel", "Refers to: Channel nope",
+		"DestinationNotAddressable: Subscription tap, spec.reply", "Refers to: ConfigMap settings",
+		explanations[reasonBrokerNotFound]))
+	// Kubernetes services are addressable without an address in their status
+	assert.Assert(t, util.ContainsNone(output, "Service legacy"))
+}
+
+func TestEventingDoctorJSON(t *testing.T) {
+	output, err := executeEventingCommand(newDoctorParams(), "doctor", "-o", "json")
+	assertExitCode(t, err, 1)
+	var report checkReport
+	assert.NilError(t, json.Unmarshal([]byte(output), &report))
+	assert.Equal(t, report.Namespace, "default")
+	assert.Equal(t, report.Checked, 8)
+	assert.Equal(t, len(report.Findings), 5)
+	assert.DeepEqual(t, report.Findings[2], finding{
+		Reason:   reasonDestinationNotReady,
+		Resource: "Trigger ping",
+		Field:    "spec.subscriber",
+		Target:   "Service display",
+		Message:  "Revision failed",
+	})
+
+	output, err = executeEventingCommand(newTestParams(nil), "doctor", "-o", "json")
+	assert.NilError(t, err)
+	assert.NilError(t, json.Unmarshal([]byte(output), &report))
+	assert.Equal(t, report.Checked, 0)
+	assert.Assert(t, report.Findings != nil && len(report.Findings) == 0)
+}
+
+func TestEventingDoctorErrors(t *testing.T) {
+	_, err := executeEventingCommand(newTestParams(nil), "doctor", "-o", "yaml")
+	assert.ErrorContains(t, err, "invalid value for output flag")
+	assertExitCode(t, err, 2)
+	_, err = executeEventingCommand(newTestParams(nil), "doctor", "foo")
+	assert.ErrorContains(t, err, "doesn't accept arguments")
+	assertExitCode(t, err, 2)
+	_, err = executeEventingCommand(newTestParams(nil), "doctor", "--no-such-flag")
+	assert.ErrorContains(t, err, "unknown flag")
+	assertExitCode(t, err, 2)
+}
+
+func assertExitCode(t *testing.T, err error, code int) {
+	var exitCodeError *knerrors.ExitCodeError
+	assert.Assert(t, errors.As(err, &exitCodeError))
+	assert.Equal(t, exitCodeError.ExitCode(), code)
+	if code == 1 {
+		// the findings have been printed already
+		assert.Equal(t, exitCodeError.Error(), "")
+	}
+}
+
+// newDoctorParams returns parameters for a namespace with a problem for every reason
+func newDoctorParams() *commands.KnParams {
+	legacy := &corev1.Service{}
+	legacy.Name, legacy.Namespace = "legacy", "default"
+	settings := &corev1.ConfigMap{}
+	settings.Name, settings.Namespace = "settings", "default"
+	return newTestParams([]runtime.Object{
+		newReadyBroker("default", ksvc("dls")),
+		newTrigger("orphan", "gone", duckv1.Destination{URI: apis.HTTP("example.com")}, nil),
+		newTrigger("ping", "default", *ksvc("display"), nil),
+		newSubscription("tap", "nope",
+			&duckv1.Destination{Ref: &duckv1.KReference{Kind: "Service", APIVersion: "v1", Name: "legacy"}},
+			&duckv1.Destination{Ref: &duckv1.KReference{Kind: "ConfigMap", APIVersion: "v1", Name: "settings"}}),
+	}, newKnativeService("display", corev1.ConditionFalse, "Revision failed"), legacy, settings)
+}
+
+func newReadyBroker(name string, deadLetterSink *duckv1.Destination) *eventingv1.Broker {
+	broker := newBroker(name, deadLetterSink)
+	broker.Status.Address = &duckv1.Addressable{URL: apis.HTTP("broker-ingress.knative-eventing.svc.cluster.local")}
+	broker.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+	return broker
+}
+
+func newKnativeService(name string, ready corev1.ConditionStatus, message string) *servingv1.Service {
+	service := &servingv1.Service{}
+	service.Name, service.Namespace = name, "default"
+	service.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: ready, Message: message}}
+	if ready == corev1.ConditionTrue {
+		service.Status.Address = &duckv1.Addressable{URL: apis.HTTP(name + ".default.svc.cluster.local")}
+	}
+	return service
+}
@@ -0,0 +1,36 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+)
+
+// NewEventingCommand represents the commands for inspecting the eventing resources of a namespace
+func NewEventingCommand(p *commands.KnParams) *cobra.Command {
+	eventingCmd := &cobra.Command{
+		Use:   "eventing SUBCOMMAND",
+		Short: "Inspect how events flow between eventing resources",
+		Long: `Inspect how events flow between eventing resources
+
+The sources, brokers, triggers, channels and subscriptions of a namespace are
+examined together, following the references between them.`,
+	}
+	eventingCmd.AddCommand(NewEventingGraphCommand(p))
+	eventingCmd.AddCommand(NewEventingDoctorCommand(p))
+	return eventingCmd
+}
@@ -0,0 +1,128 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"bytes"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	eventingfake "knative.dev/eventing/pkg/client/clientset/versioned/fake"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/commands"
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	clientmessagingv1 "knative.dev/client/pkg/messaging/v1"
+)
+
+// newTestParams returns parameters with clients serving the given objects. Sources and
+// the resources referenced as destinations are served by the dynamic client.
+func newTestParams(eventingObjects []runtime.Object, dynamicObjects ...runtime.Object) *commands.KnParams {
+	eventingClient := eventingfake.NewSimpleClientset(eventingObjects...)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", dynamicObjects...)
+	return &commands.KnParams{
+		NewEventingClient: func(namespace string) (clienteventingv1.KnEventingClient, error) {
+			return clienteventingv1.NewKnEventingClient(eventingClient.EventingV1(), namespace), nil
+		},
+		NewMessagingClient: func(namespace string) (clientmessagingv1.KnMessagingClient, error) {
+			return clientmessagingv1.NewKnMessagingClient(eventingClient.MessagingV1(), namespace), nil
+		},
+		NewDynamicClient: func(namespace string) (clientdynamic.KnDynamicClient, error) {
+			return dynamicClient, nil
+		},
+	}
+}
+
+func executeEventingCommand(p *commands.KnParams, args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	p.Output = output
+
+	cmd := NewEventingCommand(p)
+	// like the root command, as findings are reported by the exit code
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func newBroker(name string, deadLetterSink *duckv1.Destination) *eventingv1.Broker {
+	broker := &eventingv1.Broker{}
+	broker.Name, broker.Namespace = name, "default"
+	if deadLetterSink != nil {
+		broker.Spec.Delivery = &eventingduckv1.DeliverySpec{DeadLetterSink: deadLetterSink}
+	}
+	return broker
+}
+
+func newTrigger(name, broker string, subscriber duckv1.Destination, filter map[string]string) *eventingv1.Trigger {
+	trigger := &eventingv1.Trigger{}
+	trigger.Name, trigger.Namespace = name, "default"
+	trigger.Spec.Broker = broker
+	trigger.Spec.Subscriber = subscriber
+	if filter != nil {
+		trigger.Spec.Filter = &eventingv1.TriggerFilter{Attributes: filter}
+	}
+	return trigger
+}
+
+func newChannel(name string) *messagingv1.Channel {
+	channel := &messagingv1.Channel{}
+	channel.Name, channel.Namespace = name, "default"
+	return channel
+}
+
+func newSubscription(name, channel string, subscriber, reply *duckv1.Destination) *messagingv1.Subscription {
+	subscription := &messagingv1.Subscription{}
+	subscription.Name, subscription.Namespace = name, "default"
+	subscription.Spec.Channel = duckv1.KReference{Kind: "Channel", APIVersion: "messaging.knative.dev/v1", Name: channel}
+	subscription.Spec.Subscriber = subscriber
+	subscription.Spec.Reply = reply
+	return subscription
+}
+
+// newPingSource returns the source type of ping sources and a ping source with the given sink
+func newPingSource(name string, sink map[string]interface{}) []runtime.Object {
+	sourceType := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]interface{}{
+			"name":   "pingsources.sources.knative.dev",
+			"labels": map[string]interface{}{"duck.knative.dev/source": "true"},
+		},
+		"spec": map[string]interface{}{
+			"group":   "sources.knative.dev",
+			"version": "v1",
+			"names":   map[string]interface{}{"kind": "PingSource", "plural": "pingsources"},
+		},
+	}}
+	source := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "sources.knative.dev/v1",
+		"kind":       "PingSource",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"spec":       map[string]interface{}{"sink": sink},
+	}}
+	return []runtime.Object{sourceType, source}
+}
+
+func ksvc(name string) *duckv1.Destination {
+	return &duckv1.Destination{Ref: &duckv1.KReference{Kind: "Service", APIVersion: "serving.knative.dev/v1", Name: name}}
+}
@@ -0,0 +1,180 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+)
+
+var graphExample = `
+  # Show how events flow between the eventing resources of the current namespace
+  kn eventing graph
+
+  # Render the graph of namespace 'shop' with Graphviz
+  kn eventing graph -n shop -o dot | dot -Tsvg > shop.svg
+
+  # Render the graph as Mermaid flowchart, e.g. for embedding it in Markdown
+  kn eventing graph -o mermaid`
+
+// NewEventingGraphCommand creates a new command for showing the flow of events in a namespace
+func NewEventingGraphCommand(p *commands.KnParams) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "graph",
+		Short: "Show how events flow between sources, brokers, triggers, channels and subscriptions",
+		Long: `Show how events flow between sources, brokers, triggers, channels and subscriptions
+
+The sinks of sources, the triggers of brokers, the subscriptions of channels and
+the subscribers, replies and dead letter sinks they point to are shown as directed
+graph. References to resources which don't exist are flagged as dangling.`,
+		Example: graphExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("'kn eventing graph' doesn't accept arguments")
+			}
+			var write func(io.Writer, *topology) error
+			switch output {
+			case "text":
+				write = writeText
+			case "dot":
+				write = writeDot
+			case "mermaid":
+				write = writeMermaid
+			default:
+				return fmt.Errorf("invalid value for output flag, choose one among 'text', 'dot' or 'mermaid'")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := loadTopology(cmd.Context(), p, namespace)
+			if err != nil {
+				return err
+			}
+			return write(cmd.OutOrStdout(), t)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVarP(&output, "output", "o", "text", "Output format. One of: text|dot|mermaid.")
+	return command
+}
+
+// writeText writes the resources of the namespace with the edges leaving them,
+// followed by the dangling references
+func writeText(w io.Writer, t *topology) error {
+	if len(t.nodes) == 0 {
+		_, err := fmt.Fprintf(w, "No eventing resources found in namespace '%s'.\n", t.namespace)
+		return err
+	}
+	for _, n := range t.nodes {
+		out := t.edgesFrom(n.ID)
+		if !n.Listed && len(out) == 0 {
+			continue
+		}
+		fmt.Fprintln(w, n.Label())
+		for _, e := range out {
+			arrow := "-->"
+			if e.Label != "" {
+				arrow = "--" + e.Label + "-->"
+			}
+			target := t.index[e.To]
+			suffix := ""
+			if target.Missing {
+				suffix = " (not found)"
+			}
+			fmt.Fprintf(w, "  %s %s%s\n", arrow, target.Label(), suffix)
+		}
+	}
+	missing := t.Missing()
+	if len(missing) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Dangling references:")
+	for _, n := range missing {
+		var from []string
+		for _, id := range n.ReferencedBy {
+			from = append(from, t.index[id].Label())
+		}
+		fmt.Fprintf(w, "  %s, referenced by %s\n", n.Label(), strings.Join(from, ", "))
+	}
+	return nil
+}
+
+// writeDot writes the graph in the DOT language of Graphviz
+func writeDot(w io.Writer, t *topology) error {
+	fmt.Fprintf(w, "digraph %q {\n", t.namespace)
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintln(w, "  node [shape=box];")
+	for _, n := range t.nodes {
+		label := n.Name
+		if n.Kind != "" {
+			label = n.Kind + "\n" + n.Name
+		}
+		attrs := fmt.Sprintf("label=%q", label)
+		if n.Missing {
+			attrs += `, style=dashed, color=red, xlabel="not found"`
+		}
+		fmt.Fprintf(w, "  %q [%s];\n", n.ID, attrs)
+	}
+	for _, e := range t.edges {
+		if e.Label == "" {
+			fmt.Fprintf(w, "  %q -> %q;\n", e.From, e.To)
+		} else {
+			fmt.Fprintf(w, "  %q -> %q [label=%q];\n", e.From, e.To, e.Label)
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+// writeMermaid writes the graph as Mermaid flowchart
+func writeMermaid(w io.Writer, t *topology) error {
+	fmt.Fprintln(w, "flowchart LR")
+	ids := map[string]string{}
+	var missing []string
+	for i, n := range t.nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+		label := n.Label()
+		if n.Missing {
+			label += " (not found)"
+			missing = append(missing, ids[n.ID])
+		}
+		fmt.Fprintf(w, "  %s[\"%s\"]\n", ids[n.ID], mermaidEscape(label))
+	}
+	for _, e := range t.edges {
+		if e.Label == "" {
+			fmt.Fprintf(w, "  %s --> %s\n", ids[e.From], ids[e.To])
+		} else {
+			fmt.Fprintf(w, "  %s -->|\"%s\"| %s\n", ids[e.From], mermaidEscape(e.Label), ids[e.To])
+		}
+	}
+	if len(missing) > 0 {
+		fmt.Fprintln(w, "  classDef missing stroke:#d00,stroke-dasharray:5 5")
+		fmt.Fprintf(w, "  class %s missing\n", strings.Join(missing, ","))
+	}
+	return nil
+}
+
+// mermaidEscape escapes the quotes in a label of a Mermaid flowchart
+func mermaidEscape(label string) string {
+	return strings.ReplaceAll(label, `"`, "#quot;")
+}
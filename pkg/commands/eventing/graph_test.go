@@ -0,0 +1,118 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func TestEventingGraphText(t *testing.T) {
+	p := newGraphParams()
+	output, err := executeEventingCommand(p, "graph")
+	assert.NilError(t, err)
+	assert.Equal(t, output, `PingSource heartbeat
+  --sink--> Broker default
+Broker default
+  --dead letter--> Service dls (not found)
+  --type=dev.example.ping--> Trigger ping
+Trigger orphan
+  --subscriber--> https://example.com/hook
+Trigger ping
+  --subscriber--> Service display
+Channel pipe
+  --> Subscription tap
+Subscription tap
+  --subscriber--> Service display
+  --reply--> Broker default
+Broker gone
+  --> Trigger orphan
+
+Dangling references:
+  Service dls, referenced by Broker default
+  Broker gone, referenced by Trigger orphan
+`)
+}
+
+func TestEventingGraphDot(t *testing.T) {
+	output, err := executeEventingCommand(newGraphParams(), "graph", "-o", "dot")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(output, "digraph \"default\" {\n  rankdir=LR;\n"))
+	assert.Assert(t, util.ContainsAll(output,
+		`"broker:default" [label="Broker\ndefault"];`,
+		`"ksvc:dls" [label="Service\ndls", style=dashed, color=red, xlabel="not found"];`,
+		`"pingsources.sources.knative.dev/v1:heartbeat" -> "broker:default" [label="sink"];`,
+		`"broker:default" -> "trigger:ping" [label="type=dev.example.ping"];`,
+		`"channel:pipe" -> "subscription:tap";`,
+		`"subscription:tap" -> "broker:default" [label="reply"];`))
+	assert.Assert(t, strings.HasSuffix(output, "}\n"))
+}
+
+func TestEventingGraphMermaid(t *testing.T) {
+	output, err := executeEventingCommand(newGraphParams(), "graph", "-o", "mermaid")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(output, "flowchart LR\n  n0[\"PingSource heartbeat\"]\n  n1[\"Broker default\"]\n"))
+	assert.Assert(t, util.ContainsAll(output,
+		`n0 -->|"sink"| n1`,
+		`n1 -->|"type=dev.example.ping"| n3`,
+		`["Service dls (not found)"]`,
+		"classDef missing",
+		"class n6,n7 missing"))
+}
+
+func TestEventingGraphEmpty(t *testing.T) {
+	output, err := executeEventingCommand(newTestParams(nil), "graph")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "No eventing resources found in namespace 'default'.\n")
+}
+
+func TestEventingGraphErrors(t *testing.T) {
+	_, err := executeEventingCommand(newTestParams(nil), "graph", "-o", "svg")
+	assert.ErrorContains(t, err, "invalid value for output flag")
+	_, err = executeEventingCommand(newTestParams(nil), "graph", "foo")
+	assert.ErrorContains(t, err, "doesn't accept arguments")
+}
+
+func TestMermaidEscape(t *testing.T) {
+	assert.Equal(t, mermaidEscape(`source="x"`), "source=#quot;x#quot;")
+}
+
+// newGraphParams returns parameters for a namespace with a source sending to a broker,
+// a channel and dangling references to a service and a broker
+func newGraphParams() *commands.KnParams {
+	display := &servingv1.Service{}
+	display.Name, display.Namespace = "display", "default"
+	hook, _ := apis.ParseURL("https://example.com/hook")
+	dynamicObjects := append(newPingSource("heartbeat", map[string]interface{}{
+		"ref": map[string]interface{}{"apiVersion": "eventing.knative.dev/v1", "kind": "Broker", "name": "default"},
+	}), display)
+	return newTestParams([]runtime.Object{
+		newBroker("default", ksvc("dls")),
+		newTrigger("ping", "default", *ksvc("display"), map[string]string{"type": "dev.example.ping"}),
+		newTrigger("orphan", "gone", duckv1.Destination{URI: hook}, nil),
+		newChannel("pipe"),
+		newSubscription("tap", "pipe", ksvc("display"), &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Broker", APIVersion: "eventing.knative.dev/v1", Name: "default"}}),
+	}, dynamicObjects...)
+}
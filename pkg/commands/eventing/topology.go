@@ -0,0 +1,413 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/commands"
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/flags/sink"
+	"knative.dev/client/pkg/sources"
+)
+
+// node is a resource taking part in the flow of events
+type node struct {
+	ID   string
+	Kind string
+	Name string
+	// Listed is set for the resources found in the namespace, in contrast to those
+	// which are only known by being referenced
+	Listed bool
+	// Missing is set for referenced resources which don't exist
+	Missing bool
+	// Address is the address of the resource, if it is addressable
+	Address *apis.URL
+	// Ready is the ready condition of the resource, if it has one
+	Ready *apis.Condition
+	// Ref is the reference to a resource which is only known by being referenced
+	Ref *sink.Reference
+	// ReferencedBy are the IDs of the nodes referencing the resource
+	ReferencedBy []string
+}
+
+// Label returns the kind and the name of the node
+func (n *node) Label() string {
+	if n.Kind == "" {
+		return n.Name
+	}
+	return n.Kind + " " + n.Name
+}
+
+// edge is the way events take from one node to another, e.g. from a broker to a trigger
+type edge struct {
+	From  string
+	To    string
+	Label string
+	// Field is the field of the resource holding the reference, like spec.subscriber
+	Field string
+}
+
+// topology holds the eventing resources of a namespace and the references between them
+type topology struct {
+	namespace string
+	nodes     []*node
+	index     map[string]*node
+	edges     []edge
+}
+
+func newTopology(namespace string) *topology {
+	return &topology{namespace: namespace, index: map[string]*node{}}
+}
+
+// loadTopology lists the sources, brokers, triggers, channels and subscriptions of the
+// namespace, adds the references between them and marks the referenced resources
+// which don't exist
+func loadTopology(ctx context.Context, p *commands.KnParams, namespace string) (*topology, error) {
+	dynamicClient, err := p.NewDynamicClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	eventingClient, err := p.NewEventingClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	messagingClient, err := p.NewMessagingClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceList, err := listSources(ctx, dynamicClient)
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	brokers, err := eventingClient.ListBrokers(ctx)
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	triggers, err := eventingClient.ListTriggers(ctx)
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	channels, err := messagingClient.ChannelsClient().ListChannel(ctx)
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	subscriptions, err := messagingClient.SubscriptionsClient().ListSubscription(ctx)
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+
+	t := newTopology(namespace)
+	// all listed resources are added first, so that references to them are recognized
+	var sourceIDs []string
+	for i := range sourceList {
+		u := &sourceList[i]
+		ref := &duckv1.KReference{Kind: u.GetKind(), APIVersion: u.GetAPIVersion(), Name: u.GetName()}
+		sourceIDs = append(sourceIDs, t.addListed(t.refID(ref), u.GetKind(), u.GetName()))
+	}
+	for _, broker := range brokers.Items {
+		n := t.index[t.addListed(t.brokerID(broker.Name), "Broker", broker.Name)]
+		n.Address, n.Ready = addressURL(broker.Status.Address), broker.Status.GetCondition(apis.ConditionReady)
+	}
+	for _, trigger := range triggers.Items {
+		t.addListed(triggerID(trigger.Name), "Trigger", trigger.Name)
+	}
+	for _, channel := range channels.Items {
+		n := t.index[t.addListed(t.channelID(channel.Name), "Channel", channel.Name)]
+		n.Address, n.Ready = addressURL(channel.Status.Address), channel.Status.GetCondition(apis.ConditionReady)
+	}
+	for _, subscription := range subscriptions.Items {
+		t.addListed(subscriptionID(subscription.Name), "Subscription", subscription.Name)
+	}
+
+	for i := range sourceList {
+		var source duckv1.Source
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(sourceList[i].Object, &source); err != nil {
+			return nil, fmt.Errorf("cannot read the sink of %s '%s': %w", sourceList[i].GetKind(), sourceList[i].GetName(), err)
+		}
+		t.addDestination(sourceIDs[i], &source.Spec.Sink, "sink", "spec.sink")
+	}
+	for _, broker := range brokers.Items {
+		t.addDelivery(t.brokerID(broker.Name), broker.Spec.Delivery)
+	}
+	for _, trigger := range triggers.Items {
+		id := triggerID(trigger.Name)
+		broker := t.reference(id, &duckv1.Destination{Ref: t.brokerRef(trigger)})
+		t.addEdge(broker, id, filterLabel(trigger.Spec), "spec.broker")
+		t.addDestination(id, &trigger.Spec.Subscriber, "subscriber", "spec.subscriber")
+		t.addDelivery(id, trigger.Spec.Delivery)
+	}
+	for _, channel := range channels.Items {
+		t.addDelivery(t.channelID(channel.Name), channel.Spec.Delivery)
+	}
+	for _, subscription := range subscriptions.Items {
+		id := subscriptionID(subscription.Name)
+		channel := subscription.Spec.Channel
+		t.addEdge(t.reference(id, &duckv1.Destination{Ref: &channel}), id, "", "spec.channel")
+		if subscription.Spec.Subscriber != nil {
+			t.addDestination(id, subscription.Spec.Subscriber, "subscriber", "spec.subscriber")
+		}
+		if subscription.Spec.Reply != nil {
+			t.addDestination(id, subscription.Spec.Reply, "reply", "spec.reply")
+		}
+		t.addDelivery(id, subscription.Spec.Delivery)
+	}
+
+	if err := t.lookup(ctx, dynamicClient); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// listSources returns the sources of all installed source types, or of the built-in
+// source types if the source types can't be looked up
+func listSources(ctx context.Context, client clientdynamic.KnDynamicClient) ([]unstructured.Unstructured, error) {
+	sourceTypes, err := client.ListSourcesTypes(ctx)
+	switch {
+	case knerrors.IsForbiddenError(err):
+		gvks := sources.BuiltInSourcesGVKs()
+		sourceList, err := client.ListSourcesUsingGVKs(ctx, &gvks)
+		if err != nil {
+			return nil, err
+		}
+		return sourceList.Items, nil
+	case err != nil:
+		return nil, err
+	case len(sourceTypes.Items) == 0:
+		return nil, nil
+	}
+	sourceList, err := client.ListSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sourceList.Items, nil
+}
+
+// addListed adds a resource found in the namespace and returns its ID
+func (t *topology) addListed(id, kind, name string) string {
+	n := t.add(id, kind, name)
+	n.Listed = true
+	return id
+}
+
+// addReferenced adds a resource known by being referenced, unless it has been added already
+func (t *topology) addReferenced(id, kind, name string, ref *sink.Reference) *node {
+	n := t.add(id, kind, name)
+	if !n.Listed && n.Ref == nil {
+		n.Ref = ref
+	}
+	return n
+}
+
+func (t *topology) add(id, kind, name string) *node {
+	if n, ok := t.index[id]; ok {
+		return n
+	}
+	n := &node{ID: id, Kind: kind, Name: name}
+	t.nodes = append(t.nodes, n)
+	t.index[id] = n
+	return n
+}
+
+func (t *topology) addEdge(from, to, label, field string) {
+	t.edges = append(t.edges, edge{From: from, To: to, Label: label, Field: field})
+}
+
+// addDestination adds the resource or URL the destination points to and an edge to it
+func (t *topology) addDestination(from string, dest *duckv1.Destination, label, field string) {
+	if to := t.reference(from, dest); to != "" {
+		t.addEdge(from, to, label, field)
+	}
+}
+
+// reference adds the resource or URL the destination of the given node points to and
+// returns its ID, or an empty string for an empty destination
+func (t *topology) reference(from string, dest *duckv1.Destination) string {
+	ref := sink.GuessFromDestination(*dest)
+	if ref == nil {
+		return ""
+	}
+	var n *node
+	if ref.Type() == sink.TypeURL {
+		n = t.addReferenced(ref.URL.String(), "", ref.URL.String(), ref)
+	} else {
+		if ref.Namespace == "" {
+			ref.Namespace = t.namespace
+		}
+		n = t.addReferenced(ref.AsText(t.namespace), dest.Ref.Kind, nameInNamespace(ref.Name, ref.Namespace, t.namespace), ref)
+	}
+	if !slices.Contains(n.ReferencedBy, from) {
+		n.ReferencedBy = append(n.ReferencedBy, from)
+	}
+	return n.ID
+}
+
+// addDelivery adds the dead letter sink of the delivery spec, if any
+func (t *topology) addDelivery(from string, delivery *eventingduckv1.DeliverySpec) {
+	if delivery == nil || delivery.DeadLetterSink == nil {
+		return
+	}
+	t.addDestination(from, delivery.DeadLetterSink, "dead letter", "spec.delivery.deadLetterSink")
+}
+
+// lookup looks up the referenced resources for their address and ready condition, and
+// marks those which don't exist. Brokers and channels of the namespace are known from
+// listing them.
+func (t *topology) lookup(ctx context.Context, client clientdynamic.KnDynamicClient) error {
+	for _, n := range t.nodes {
+		if n.Listed || n.Ref == nil || n.Ref.Type() != sink.TypeReference {
+			continue
+		}
+		if n.Ref.Namespace == t.namespace && listedKinds[n.Ref.GVR.Resource+"."+n.Ref.GVR.Group] {
+			n.Missing = true
+			continue
+		}
+		obj, err := client.RawClient().Resource(n.Ref.GVR).Namespace(n.Ref.Namespace).Get(ctx, n.Ref.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			n.Missing = true
+			continue
+		case err != nil:
+			return knerrors.GetError(err)
+		}
+		var resource addressableResource
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &resource); err != nil {
+			return fmt.Errorf("cannot read the status of %s: %w", n.Label(), err)
+		}
+		n.Address = addressURL(resource.Status.Address)
+		n.Ready = resource.Status.GetCondition(apis.ConditionReady)
+	}
+	return nil
+}
+
+// addressableResource is the part of a referenced resource needed to send events to it
+type addressableResource struct {
+	Status struct {
+		duckv1.Status `json:",inline"`
+		Address       *duckv1.Addressable `json:"address,omitempty"`
+	} `json:"status"`
+}
+
+func addressURL(address *duckv1.Addressable) *apis.URL {
+	if address == nil {
+		return nil
+	}
+	return address.URL
+}
+
+// Missing returns the referenced resources which don't exist
+func (t *topology) Missing() []*node {
+	var missing []*node
+	for _, n := range t.nodes {
+		if n.Missing {
+			missing = append(missing, n)
+		}
+	}
+	return missing
+}
+
+// edgesFrom returns the edges leaving the given node
+func (t *topology) edgesFrom(id string) []edge {
+	var out []edge
+	for _, e := range t.edges {
+		if e.From == id {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// listedKinds are the resources which are listed completely for the namespace
+var listedKinds = map[string]bool{
+	"brokers.eventing.knative.dev":   true,
+	"channels.messaging.knative.dev": true,
+}
+
+func (t *topology) refID(ref *duckv1.KReference) string {
+	r := sink.GuessFromDestination(duckv1.Destination{Ref: ref})
+	if r.Namespace == "" {
+		r.Namespace = t.namespace
+	}
+	return r.AsText(t.namespace)
+}
+
+func (t *topology) brokerID(name string) string {
+	return t.refID(&duckv1.KReference{Kind: "Broker", APIVersion: "eventing.knative.dev/v1", Name: name})
+}
+
+// brokerRef returns the reference to the broker of a trigger
+func (t *topology) brokerRef(trigger eventingv1.Trigger) *duckv1.KReference {
+	ref := trigger.GetCrossNamespaceRef()
+	if ref.Name == "" {
+		ref.Name = trigger.Spec.Broker
+	}
+	if ref.Kind == "" {
+		ref.Kind, ref.APIVersion = "Broker", "eventing.knative.dev/v1"
+	}
+	if ref.Namespace == "" {
+		ref.Namespace = t.namespace
+	}
+	return &ref
+}
+
+func (t *topology) channelID(name string) string {
+	return t.refID(&duckv1.KReference{Kind: "Channel", APIVersion: "messaging.knative.dev/v1", Name: name})
+}
+
+func triggerID(name string) string {
+	return "trigger:" + name
+}
+
+func subscriptionID(name string) string {
+	return "subscription:" + name
+}
+
+// nameInNamespace returns the name, qualified with the namespace if it differs from the
+// current one
+func nameInNamespace(name, namespace, current string) string {
+	if namespace != "" && namespace != current {
+		return namespace + "/" + name
+	}
+	return name
+}
+
+// filterLabel describes the filter of a trigger, like "type=dev.example.ping"
+func filterLabel(spec eventingv1.TriggerSpec) string {
+	var parts []string
+	if spec.Filter != nil {
+		for _, key := range slices.Sorted(maps.Keys(spec.Filter.Attributes)) {
+			parts = append(parts, key+"="+spec.Filter.Attributes[key])
+		}
+	}
+	if len(spec.Filters) > 0 {
+		parts = append(parts, fmt.Sprintf("%d filter expression(s)", len(spec.Filters)))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,59 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestLoadTopologyCrossNamespace(t *testing.T) {
+	trigger := newTrigger("remote", "", *ksvc("display"), nil)
+	trigger.Spec.BrokerRef = &duckv1.KReference{Name: "central", Namespace: "infra"}
+	subscription := newSubscription("tap", "pipe", ksvc("display"), nil)
+	subscription.Spec.Channel.Kind = "InMemoryChannel"
+	central := newBroker("central", nil)
+	central.Namespace = "infra"
+
+	p := newTestParams([]runtime.Object{trigger, subscription}, central)
+	topology, err := loadTopology(context.Background(), p, "default")
+	assert.NilError(t, err)
+
+	broker := topology.index["broker:central:infra"]
+	assert.Assert(t, broker != nil)
+	assert.Equal(t, broker.Label(), "Broker infra/central")
+	assert.Assert(t, !broker.Missing)
+	assert.DeepEqual(t, broker.ReferencedBy, []string{"trigger:remote"})
+
+	channel := topology.index["inmemorychannels.messaging.knative.dev/v1:pipe"]
+	assert.Assert(t, channel != nil)
+	assert.Assert(t, channel.Missing)
+	assert.Equal(t, len(topology.Missing()), 2)
+	assert.Equal(t, topology.index["ksvc:display"].Missing, true)
+}
+
+func TestFilterLabel(t *testing.T) {
+	spec := eventingv1.TriggerSpec{
+		Filter:  &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{"type": "a", "source": "b"}},
+		Filters: []eventingv1.SubscriptionsAPIFilter{{Prefix: map[string]string{"type": "dev."}}},
+	}
+	assert.Equal(t, filterLabel(spec), "source=b, type=a, 1 filter expression(s)")
+	assert.Equal(t, filterLabel(eventingv1.TriggerSpec{}), "")
+}
@@ -32,6 +32,11 @@ type ListPrintFlags struct {
 	GenericPrintFlags  *genericclioptions.PrintFlags
 	HumanReadableFlags *commands.HumanPrintFlags
 	PrinterHandler     func(h hprinters.PrintHandler)
+	// Watch is set if changes should be printed after the list, see PrintWatch
+	Watch bool
+	// ToPrintable converts listed objects and lists for the human readable printer,
+	// if they are not printed as they are
+	ToPrintable func(obj runtime.Object) runtime.Object
 }
 
 // AllowedFormats is the list of formats in which data can be displayed
@@ -0,0 +1,331 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"knative.dev/client/pkg/output/term"
+	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
+)
+
+const clearScreen = "\x1b[H\x1b[2J"
+
+// isTerminal decides whether the whole table is printed again on changes,
+// can be replaced in tests
+var isTerminal = term.IsWriterTerminal
+
+// rewatchDelay is the time to wait before watching again after the server closed a watch
+var rewatchDelay = time.Second
+
+// WatchFunc starts watching the listed objects for changes after the given resource version
+type WatchFunc func(ctx context.Context, resourceVersion string) (watch.Interface, error)
+
+// AddWatchFlag adds the flag for watching the listed objects for changes
+func (f *ListPrintFlags) AddWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.Watch, "watch", "w", false, "After listing, watch for changes and print them until interrupted.")
+}
+
+// PrintWatch prints the list like Print and then the changes reported by the watch until
+// the context is done or the command is interrupted. On a terminal, the whole table is printed again for each change,
+// otherwise only the rows of objects whose printed columns have changed are printed.
+// With an output format, each changed object is printed as a whole. A watch closed by the
+// server is started again from the last seen resource version.
+func (f *ListPrintFlags) PrintWatch(ctx context.Context, list runtime.Object, watchFunc WatchFunc, w io.Writer) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+	watched := &watchedObjects{list: list, printed: map[string]string{}, objects: map[string]runtime.Object{}}
+	for _, item := range items {
+		key, err := watchKey(item)
+		if err != nil {
+			return err
+		}
+		watched.add(key, item)
+	}
+
+	printer, err := f.newWatchPrinter(watched, w)
+	if err != nil {
+		return err
+	}
+	if err := printer.printList(); err != nil {
+		return err
+	}
+
+	resourceVersion := listAccessor.GetResourceVersion()
+	for {
+		watcher, err := watchFunc(ctx, resourceVersion)
+		if err != nil {
+			return err
+		}
+		resourceVersion, err = printer.printEvents(ctx, watcher, resourceVersion)
+		watcher.Stop()
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case apierrors.IsResourceExpired(err) || apierrors.IsGone(err):
+			// all objects are reported again, but only the changed ones are printed
+			resourceVersion = ""
+		case err != nil:
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+// watchedObjects are the current objects of a watched list in the order they were listed or added
+type watchedObjects struct {
+	list    runtime.Object
+	keys    []string
+	objects map[string]runtime.Object
+	// printed holds the last printed row or resource version of each object
+	printed map[string]string
+}
+
+func (o *watchedObjects) add(key string, obj runtime.Object) {
+	if _, found := o.objects[key]; !found {
+		o.keys = append(o.keys, key)
+	}
+	o.objects[key] = obj
+}
+
+func (o *watchedObjects) remove(key string) {
+	if _, found := o.objects[key]; !found {
+		return
+	}
+	delete(o.objects, key)
+	delete(o.printed, key)
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// toList returns a copy of the watched list with the current objects as items
+func (o *watchedObjects) toList() (runtime.Object, error) {
+	list := o.list.DeepCopyObject()
+	items := make([]runtime.Object, 0, len(o.keys))
+	for _, key := range o.keys {
+		items = append(items, o.objects[key])
+	}
+	return list, meta.SetList(list, items)
+}
+
+type watchPrinter struct {
+	flags   *ListPrintFlags
+	watched *watchedObjects
+	out     io.Writer
+	// generic prints objects in the given output format, nil for tables
+	generic hprinters.ResourcePrinter
+	// table prints the whole table, rows prints the rows of single objects
+	table, rows hprinters.ResourcePrinter
+	terminal    bool
+	headers     bool
+}
+
+func (f *ListPrintFlags) newWatchPrinter(watched *watchedObjects, w io.Writer) (*watchPrinter, error) {
+	p := &watchPrinter{flags: f, watched: watched, out: w, terminal: isTerminal(w)}
+	var err error
+	if f.GenericPrintFlags.OutputFlagSpecified() {
+		p.generic, err = f.GenericPrintFlags.ToPrinter()
+		return p, err
+	}
+	if p.table, err = f.HumanReadableFlags.ToPrinter(f.PrinterHandler); err != nil {
+		return nil, err
+	}
+	rowFlags := *f.HumanReadableFlags
+	rowFlags.NoHeaders = true
+	if p.rows, err = rowFlags.ToPrinter(f.PrinterHandler); err != nil {
+		return nil, err
+	}
+	// headers of an empty list are printed with the first row only
+	p.headers = f.HumanReadableFlags.NoHeaders || len(watched.keys) > 0
+	return p, nil
+}
+
+// printList prints the initial list and remembers what has been printed for each object
+func (p *watchPrinter) printList() error {
+	if p.generic != nil {
+		unstructuredList, err := util.ToUnstructuredList(p.watched.list)
+		if err != nil {
+			return err
+		}
+		if err := p.generic.PrintObj(unstructuredList, p.out); err != nil {
+			return err
+		}
+		for key, obj := range p.watched.objects {
+			p.watched.printed[key] = resourceVersion(obj)
+		}
+		return nil
+	}
+	for key, obj := range p.watched.objects {
+		row, err := p.row(obj)
+		if err != nil {
+			return err
+		}
+		p.watched.printed[key] = row
+	}
+	if len(p.watched.keys) > 0 {
+		list, err := p.watched.toList()
+		if err != nil {
+			return err
+		}
+		return p.table.PrintObj(p.flags.printable(list), p.out)
+	}
+	return nil
+}
+
+// printEvents prints the changes until the watch is closed or the context is done
+// and returns the resource version of the last change
+func (p *watchPrinter) printEvents(ctx context.Context, watcher watch.Interface, resourceVersion string) (string, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+			if event.Type == watch.Error {
+				if status, ok := event.Object.(*metav1.Status); ok {
+					return resourceVersion, apierrors.FromObject(status)
+				}
+				return resourceVersion, fmt.Errorf("watch failed: %v", event.Object)
+			}
+			if event.Type == watch.Bookmark {
+				resourceVersion = watchResourceVersion(event.Object, resourceVersion)
+				continue
+			}
+			key, err := watchKey(event.Object)
+			if err != nil {
+				return resourceVersion, err
+			}
+			resourceVersion = watchResourceVersion(event.Object, resourceVersion)
+			if err := p.printEvent(key, event); err != nil {
+				return resourceVersion, err
+			}
+		}
+	}
+}
+
+func (p *watchPrinter) printEvent(key string, event watch.Event) error {
+	var printed string
+	if event.Type == watch.Deleted {
+		p.watched.remove(key)
+	} else {
+		p.watched.add(key, event.Object)
+	}
+
+	if p.generic != nil {
+		printed = resourceVersion(event.Object)
+		if event.Type != watch.Deleted && p.watched.printed[key] == printed {
+			return nil
+		}
+		p.watched.printed[key] = printed
+		return p.generic.PrintObj(event.Object, p.out)
+	}
+
+	row, err := p.row(event.Object)
+	if err != nil {
+		return err
+	}
+	if event.Type != watch.Deleted {
+		if p.watched.printed[key] == row {
+			return nil
+		}
+		p.watched.printed[key] = row
+	}
+	if p.terminal {
+		list, err := p.watched.toList()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(p.out, clearScreen)
+		return p.table.PrintObj(p.flags.printable(list), p.out)
+	}
+	if !p.headers {
+		p.headers = true
+		return p.table.PrintObj(p.flags.printable(event.Object), p.out)
+	}
+	_, err = io.WriteString(p.out, row)
+	return err
+}
+
+// row renders the table row of a single object
+func (p *watchPrinter) row(obj runtime.Object) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := p.rows.PrintObj(p.flags.printable(obj), buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// printable converts an object for printing it as a table
+func (f *ListPrintFlags) printable(obj runtime.Object) runtime.Object {
+	if f.ToPrintable == nil {
+		return obj
+	}
+	return f.ToPrintable(obj)
+}
+
+// watchKey identifies a watched object by its kind, namespace and name
+func watchKey(obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind + "/" + accessor.GetNamespace() + "/" + accessor.GetName(), nil
+}
+
+func resourceVersion(obj runtime.Object) string {
+	return watchResourceVersion(obj, "")
+}
+
+func watchResourceVersion(obj runtime.Object, fallback string) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil || accessor.GetResourceVersion() == "" {
+		return fallback
+	}
+	return accessor.GetResourceVersion()
+}
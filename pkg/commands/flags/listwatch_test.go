@@ -0,0 +1,189 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	hprinters "knative.dev/client/pkg/printers"
+)
+
+func TestPrintWatch(t *testing.T) {
+	flags, _ := newWatchListFlags()
+	out, events := printWatch(t, flags, newWatchServiceList("1", newWatchService("foo", "1", "Unknown")),
+		watch.Event{Type: watch.Modified, Object: newWatchService("foo", "2", "Unknown")},
+		watch.Event{Type: watch.Modified, Object: newWatchService("foo", "3", "True")},
+		watch.Event{Type: watch.Added, Object: newWatchService("bar", "4", "Unknown")},
+		watch.Event{Type: watch.Deleted, Object: newWatchService("foo", "5", "True")},
+	)
+	assert.DeepEqual(t, events, []string{"1"})
+	assert.Equal(t, out, strings.Join([]string{
+		"NAME   READY",
+		"foo    Unknown",
+		"foo   True",
+		"bar   Unknown",
+		"foo   True",
+		""}, "\n"))
+}
+
+func TestPrintWatchEmptyList(t *testing.T) {
+	flags, _ := newWatchListFlags()
+	out, _ := printWatch(t, flags, newWatchServiceList("1"),
+		watch.Event{Type: watch.Added, Object: newWatchService("foo", "2", "Unknown")},
+		watch.Event{Type: watch.Added, Object: newWatchService("bar", "3", "Unknown")},
+	)
+	assert.Equal(t, out, "NAME   READY\nfoo    Unknown\nbar   Unknown\n")
+}
+
+func TestPrintWatchTerminal(t *testing.T) {
+	isTerminal = func(io.Writer) bool { return true }
+	defer func() { isTerminal = defaultIsTerminal }()
+
+	flags, _ := newWatchListFlags()
+	out, _ := printWatch(t, flags, newWatchServiceList("1", newWatchService("foo", "1", "Unknown")),
+		watch.Event{Type: watch.Added, Object: newWatchService("bar", "2", "Unknown")},
+		watch.Event{Type: watch.Modified, Object: newWatchService("foo", "3", "True")},
+	)
+	screens := strings.Split(out, clearScreen)
+	assert.Equal(t, len(screens), 3)
+	assert.Equal(t, screens[2], "NAME   READY\nfoo    True\nbar    Unknown\n")
+}
+
+func TestPrintWatchOutputFormat(t *testing.T) {
+	flags, cmd := newWatchListFlags()
+	assert.NilError(t, cmd.Flags().Set("output", "name"))
+	out, _ := printWatch(t, flags, newWatchServiceList("1", newWatchService("foo", "1", "Unknown")),
+		watch.Event{Type: watch.Added, Object: newWatchService("foo", "1", "Unknown")},
+		watch.Event{Type: watch.Modified, Object: newWatchService("foo", "2", "True")},
+	)
+	assert.Equal(t, out, "service.serving.knative.dev/foo\nservice.serving.knative.dev/foo\n")
+}
+
+func TestPrintWatchRestart(t *testing.T) {
+	rewatchDelay = 0
+	flags, _ := newWatchListFlags()
+	var resourceVersions []string
+	watchers := []*watch.FakeWatcher{watch.NewFake(), watch.NewFake(), watch.NewFake()}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- flags.PrintWatch(ctx, newWatchServiceList("1"), func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+			resourceVersions = append(resourceVersions, resourceVersion)
+			return watchers[len(resourceVersions)-1], nil
+		}, &bytes.Buffer{})
+	}()
+	watchers[0].Add(newWatchService("foo", "2", "Unknown"))
+	watchers[0].Stop()
+	watchers[1].Error(&apierrors.NewResourceExpired("too old").ErrStatus)
+	watchers[2].Add(newWatchService("foo", "7", "True"))
+	cancel()
+	assert.NilError(t, <-done)
+	assert.DeepEqual(t, resourceVersions, []string{"1", "2", ""})
+
+	watcher := watch.NewFake()
+	go watcher.Error(&apierrors.NewForbidden(servingv1.Resource("services"), "", nil).ErrStatus)
+	err := flags.PrintWatch(context.Background(), newWatchServiceList("1"), func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+		return watcher, nil
+	}, &bytes.Buffer{})
+	assert.Assert(t, apierrors.IsForbidden(err))
+}
+
+var defaultIsTerminal = isTerminal
+
+// printWatch prints the list and the events, and returns the output and the resource
+// versions the watch has been started with
+func printWatch(t *testing.T, flags *ListPrintFlags, list *servingv1.ServiceList, events ...watch.Event) (string, []string) {
+	out := &bytes.Buffer{}
+	watcher := watch.NewFake()
+	var resourceVersions []string
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- flags.PrintWatch(ctx, list, func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+			resourceVersions = append(resourceVersions, resourceVersion)
+			return watcher, nil
+		}, out)
+	}()
+	for _, event := range events {
+		watcher.Action(event.Type, event.Object)
+	}
+	// the fake watcher is unbuffered, so the last event has been received, but maybe not printed
+	watcher.Action(watch.Bookmark, &servingv1.Service{})
+	cancel()
+	assert.NilError(t, <-done)
+	return out.String(), resourceVersions
+}
+
+func newWatchListFlags() (*ListPrintFlags, *cobra.Command) {
+	flags := NewListPrintFlags(func(h hprinters.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Priority: 1},
+			{Name: "Ready", Type: "string", Priority: 1},
+		}
+		h.TableHandler(columns, printWatchService)
+		h.TableHandler(columns, func(list *servingv1.ServiceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+			var rows []metav1beta1.TableRow
+			for i := range list.Items {
+				row, _ := printWatchService(&list.Items[i], options)
+				rows = append(rows, row...)
+			}
+			return rows, nil
+		})
+	})
+	cmd := &cobra.Command{}
+	flags.AddFlags(cmd)
+	flags.AddWatchFlag(cmd)
+	return flags, cmd
+}
+
+func printWatchService(service *servingv1.Service, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{Object: runtime.RawExtension{Object: service}}
+	row.Cells = append(row.Cells, service.Name, service.Annotations["ready"])
+	return []metav1beta1.TableRow{row}, nil
+}
+
+func newWatchService(name, resourceVersion, ready string) *servingv1.Service {
+	return &servingv1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "default", ResourceVersion: resourceVersion,
+			Annotations: map[string]string{"ready": ready},
+		},
+	}
+}
+
+func newWatchServiceList(resourceVersion string, services ...*servingv1.Service) *servingv1.ServiceList {
+	list := &servingv1.ServiceList{
+		TypeMeta: metav1.TypeMeta{Kind: "ServiceList", APIVersion: "serving.knative.dev/v1"},
+		ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion},
+	}
+	for _, service := range services {
+		list.Items = append(list.Items, *service)
+	}
+	return list
+}
@@ -287,3 +287,33 @@ func url(t testing.TB, uri string) *apis.URL {
 	assert.NilError(t, err)
 	return u
 }
+
+func TestResolveWithoutCluster(t *testing.T) {
+	cases := []resolveCase{
+		{"ksvc:mysvc", &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Service",
+				APIVersion: "serving.knative.dev/v1",
+				Namespace:  "default",
+				Name:       "mysvc"}}, ""},
+		{"broker:default:my-namespace", &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Broker",
+				APIVersion: "eventing.knative.dev/v1",
+				Namespace:  "my-namespace",
+				Name:       "default"}}, ""},
+		{"http://target.example.com", &duckv1.Destination{
+			URI: &apis.URL{Scheme: "http", Host: "target.example.com"}}, ""},
+		{"sources.knative.dev/v1:pingsource:foo", nil, "without a cluster"},
+	}
+	for _, c := range cases {
+		t.Run(c.sink, func(t *testing.T) {
+			i := &flags.SinkFlags{Sink: c.sink}
+			result, err := i.ResolveSink(context.Background(), nil, "default")
+			if c.destination != nil {
+				assert.NilError(t, err)
+				assert.DeepEqual(t, result, c.destination)
+			} else {
+				assert.ErrorContains(t, err, c.errContents)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ type Traffic struct {
 	RevisionsPercentages []string
 	RevisionsTags        []string
 	UntagRevisions       []string
+	File                 string
 }
 
 func (t *Traffic) Add(cmd *cobra.Command) {
@@ -30,6 +31,8 @@ func (t *Traffic) Add(cmd *cobra.Command) {
 	t.AddTagFlag(cmd)
 
 	t.AddUntagFlag(cmd)
+
+	t.AddTrafficFileFlag(cmd)
 }
 
 // AddUntagFlag adds the flag --untag to the command
@@ -58,6 +61,16 @@ func (t *Traffic) AddTrafficFlag(cmd *cobra.Command) {
 			"representing latest ready revision. This flag can be given multiple times with percent summing up to 100%.")
 }
 
+// AddTrafficFileFlag adds the flag --traffic-file to the command
+func (t *Traffic) AddTrafficFileFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&t.File,
+		"traffic-file",
+		"",
+		"Set the complete traffic of the service from a YAML or JSON file with a list of targets. Each target references "+
+			"a 'revision', an existing 'tag' or the 'latest' revision, and sets its 'percent' and its 'tag'. "+
+			"Tags not given in the file are removed. This flag can't be used together with --traffic, --tag or --untag.")
+}
+
 func (t *Traffic) PercentagesChanged(cmd *cobra.Command) bool {
 	return cmd.Flags().Changed("traffic")
 }
@@ -66,6 +79,11 @@ func (t *Traffic) TagsChanged(cmd *cobra.Command) bool {
 	return cmd.Flags().Changed("tag") || cmd.Flags().Changed("untag")
 }
 
+// FileChanged returns true if the traffic is given by a file
+func (t *Traffic) FileChanged(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("traffic-file")
+}
+
 func (t *Traffic) Changed(cmd *cobra.Command) bool {
-	return t.PercentagesChanged(cmd) || t.TagsChanged(cmd)
+	return t.PercentagesChanged(cmd) || t.TagsChanged(cmd) || t.FileChanged(cmd)
 }
@@ -40,10 +40,19 @@ func TestAdd(t *testing.T) {
 	assert.NilError(t, err)
 	_, err = flagList.GetStringSlice("untag")
 	assert.NilError(t, err)
+	_, err = flagList.GetString("traffic-file")
+	assert.NilError(t, err)
 	_, err = flagList.GetStringSlice("undefined")
 	assert.ErrorContains(t, err, "not defined")
 
 	assert.Equal(t, false, trafficFlags.PercentagesChanged(trafficCmd))
 	assert.Equal(t, false, trafficFlags.TagsChanged(trafficCmd))
+	assert.Equal(t, false, trafficFlags.FileChanged(trafficCmd))
 	assert.Equal(t, false, trafficFlags.Changed(trafficCmd))
+
+	trafficCmd.SetArgs([]string{"--traffic-file", "traffic.yaml"})
+	assert.NilError(t, trafficCmd.Execute())
+	assert.Equal(t, true, trafficFlags.FileChanged(trafficCmd))
+	assert.Equal(t, true, trafficFlags.Changed(trafficCmd))
+	assert.Equal(t, "traffic.yaml", trafficFlags.File)
 }
@@ -119,3 +119,23 @@ func TranslateTimestampSince(timestamp metav1.Time) string {
 func AddGitOpsFlags(flags *pflag.FlagSet) {
 	flags.String("target", "", "Work on local directory instead of a remote cluster (experimental)")
 }
+
+// GitOpsTarget returns the local directory or file given with --target,
+// or an empty string if the command works on a remote cluster
+func GitOpsTarget(cmd *cobra.Command) string {
+	flag := cmd.Flag("target")
+	if flag == nil {
+		return ""
+	}
+	return flag.Value.String()
+}
+
+// NewClientForTarget returns a client working on the local path given with --target,
+// created by newGitopsClient, or on the cluster if no target is given, created by newClient
+func NewClientForTarget[T any](cmd *cobra.Command, namespace string,
+	newGitopsClient func(namespace string, dir string) (T, error), newClient func(namespace string) (T, error)) (T, error) {
+	if dir := GitOpsTarget(cmd); dir != "" {
+		return newGitopsClient(namespace, dir)
+	}
+	return newClient(namespace)
+}
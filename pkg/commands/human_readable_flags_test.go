@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -105,3 +106,19 @@ func TestTranslateTimestampSince(t *testing.T) {
 	assert.Equal(t, "<unknown>", TranslateTimestampSince(metav1.NewTime(ts)))
 	assert.Equal(t, "0s", TranslateTimestampSince(metav1.Now()))
 }
+
+func TestNewClientForTarget(t *testing.T) {
+	newGitopsClient := func(namespace, dir string) (string, error) { return "gitops:" + namespace + ":" + dir, nil }
+	newClient := func(namespace string) (string, error) { return "cluster:" + namespace, nil }
+
+	cmd := &cobra.Command{}
+	AddGitOpsFlags(cmd.Flags())
+	client, err := NewClientForTarget(cmd, "default", newGitopsClient, newClient)
+	assert.NilError(t, err)
+	assert.Equal(t, client, "cluster:default")
+
+	assert.NilError(t, cmd.Flags().Set("target", "/tmp/repo"))
+	client, err = NewClientForTarget(cmd, "default", newGitopsClient, newClient)
+	assert.NilError(t, err)
+	assert.Equal(t, client, "gitops:default:/tmp/repo")
+}
@@ -0,0 +1,223 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"knative.dev/client/pkg/output"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/output/tui"
+)
+
+// Prompter asks for the missing input of a command in an interactive session
+type Prompter interface {
+	// Choose asks to choose one of the given options
+	Choose(options []string, format string, a ...any) (string, error)
+	// Input asks for a value, which must not be empty
+	Input(format string, a ...any) (string, error)
+}
+
+// flagsReplacingName are flags selecting the resources of a command instead of a NAME argument
+var flagsReplacingName = []string{"all", "prune", "prune-all"}
+
+// requiredUnless holds the flags which are required unless one of the given other flags is set,
+// so that they can't be marked as required. Commands are identified by their path without kn.
+var requiredUnless = map[string]map[string][]string{
+	"service create": {"image": {"filename"}},
+}
+
+// flagPrompts are the questions for missing required flags. Other flags are asked for with their usage.
+var flagPrompts = map[string]string{
+	"image":    "Image to run",
+	"sink":     "Sink to send the events to, like ksvc:NAME, broker:NAME or a URL",
+	"subject":  "Subject to bind, like Deployment:apps/v1:NAME",
+	"resource": "Resource to watch, like Event:v1",
+	"type":     "Type of the CloudEvents",
+	"ref":      "Service the domain maps to, like ksvc:NAME",
+}
+
+// AddInteractivePrompts makes all commands below the given one ask for a missing NAME argument
+// and for missing required flags, when running in an interactive session. Existing resources are
+// offered to choose from, create commands ask for the name of the new resource.
+func AddInteractivePrompts(cmd *cobra.Command, p *KnParams) {
+	for _, child := range cmd.Commands() {
+		AddInteractivePrompts(child, p)
+	}
+	if cmd.HasSubCommands() || cmd.RunE == nil {
+		return
+	}
+
+	// name is prompted before the required flags are validated, but can only be passed on to RunE
+	var name string
+	preRunE, runE := cmd.PreRunE, cmd.RunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		name = ""
+		if preRunE != nil {
+			if err := preRunE(cmd, args); err != nil {
+				return err
+			}
+		}
+		prompter := p.prompter(cmd)
+		if prompter == nil {
+			return nil
+		}
+		var err error
+		if name, err = promptName(prompter, cmd, args); err != nil {
+			return err
+		}
+		return promptFlags(prompter, cmd)
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && name != "" {
+			args = []string{name}
+		}
+		return runE(cmd, args)
+	}
+}
+
+// prompter returns the prompter for the command, or nil if it doesn't run in an interactive session
+func (params *KnParams) prompter(cmd *cobra.Command) Prompter {
+	if !params.Input || params.NewPrompter == nil {
+		return nil
+	}
+	prompter, err := params.NewPrompter(cmd)
+	if err != nil {
+		return nil
+	}
+	return prompter
+}
+
+// promptName asks for the NAME argument of the command if it's missing, and returns
+// an empty name if it isn't asked for
+func promptName(prompter Prompter, cmd *cobra.Command, args []string) (string, error) {
+	if len(args) > 0 || !takesName(cmd) {
+		return "", nil
+	}
+	for _, flag := range flagsReplacingName {
+		if f := cmd.Flags().Lookup(flag); f != nil && f.Changed {
+			return "", nil
+		}
+	}
+	if cmd.ValidArgsFunction != nil {
+		names, _ := cmd.ValidArgsFunction(cmd, nil, "")
+		if len(names) == 0 {
+			// let the command report the missing name
+			return "", nil
+		}
+		return prompter.Choose(names, "Choose the %s:", resourceKind(cmd))
+	}
+	if cmd.Name() == "create" {
+		return prompter.Input("Name of the new %s:", resourceKind(cmd))
+	}
+	return "", nil
+}
+
+// promptFlags asks for the required flags of the command which haven't been set
+func promptFlags(prompter Prompter, cmd *cobra.Command) error {
+	var missing []*pflag.Flag
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if !f.Changed && isRequired(cmd, f) {
+			missing = append(missing, f)
+		}
+	})
+	for _, f := range missing {
+		question, ok := flagPrompts[f.Name]
+		if !ok {
+			question = strings.TrimSuffix(strings.SplitN(f.Usage, "\n", 2)[0], ".")
+		}
+		value, err := prompter.Input("%s (--%s):", question, f.Name)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Flags().Set(f.Name, value); err != nil {
+			return fmt.Errorf("invalid value for --%s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// takesName returns true if the first argument of the command is the required NAME
+func takesName(cmd *cobra.Command) bool {
+	fields := strings.Fields(cmd.Use)
+	return len(fields) > 1 && fields[1] == "NAME" && cmd.Name() != "list"
+}
+
+func isRequired(cmd *cobra.Command, f *pflag.Flag) bool {
+	if required := f.Annotations[cobra.BashCompOneRequiredFlag]; len(required) == 1 && required[0] == "true" {
+		return true
+	}
+	unless, ok := requiredUnless[commandPath(cmd)][f.Name]
+	if !ok {
+		return false
+	}
+	for _, other := range unless {
+		if o := cmd.Flags().Lookup(other); o != nil && o.Changed {
+			return false
+		}
+	}
+	return true
+}
+
+// commandPath returns the path of the command without the root command
+func commandPath(cmd *cobra.Command) string {
+	path := strings.SplitN(cmd.CommandPath(), " ", 2)
+	if len(path) < 2 {
+		return ""
+	}
+	return path[1]
+}
+
+// resourceKind returns the kind of resource the command works on, like "service" or "ping source"
+func resourceKind(cmd *cobra.Command) string {
+	parent := cmd.Parent()
+	if parent == nil {
+		return "resource"
+	}
+	if parent.Parent() != nil && parent.Parent().Name() == "source" {
+		return parent.Name() + " source"
+	}
+	return parent.Name()
+}
+
+// newPrompter returns a prompter using the terminal of the command, unless running in CI
+func (params *KnParams) newPrompter(cmd *cobra.Command) (Prompter, error) {
+	if os.Getenv("CI") != "" || !term.IsWriterTerminal(cmd.OutOrStdout()) {
+		return nil, tui.ErrNotInteractive
+	}
+	iw, err := tui.NewInteractiveWidgets(output.WithContext(cmd.Context(), output.NewPrinter(cmd)))
+	if err != nil {
+		return nil, err
+	}
+	return &tuiPrompter{chooser: tui.NewChooser[string](iw), input: tui.NewInput(iw)}, nil
+}
+
+type tuiPrompter struct {
+	chooser tui.Chooser[string]
+	input   tui.Input
+}
+
+func (t *tuiPrompter) Choose(options []string, format string, a ...any) (string, error) {
+	return t.chooser.TryChoose(options, format, a...)
+}
+
+func (t *tuiPrompter) Input(format string, a ...any) (string, error) {
+	return t.input.Ask(format, a...)
+}
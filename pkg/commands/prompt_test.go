@@ -0,0 +1,186 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/output/tui"
+)
+
+func TestPromptName(t *testing.T) {
+	prompter := &fakePrompter{answers: []string{"bar"}}
+	root, calls := newPromptTestCommands(&KnParams{Input: true, NewPrompter: prompter.new})
+
+	root.SetArgs([]string{"service", "delete"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service delete"], []string{"bar"})
+	assert.DeepEqual(t, prompter.questions, []string{"Choose the service: [foo bar]"})
+
+	// nothing is asked for if the name is given, or replaced by a flag
+	root.SetArgs([]string{"service", "delete", "foo"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service delete"], []string{"foo"})
+	root.SetArgs([]string{"service", "delete", "--prune-all"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service delete"], []string{})
+	root.SetArgs([]string{"service", "delete", "--all"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service delete"], []string{})
+	root.SetArgs([]string{"service", "list"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service list"], []string{})
+	assert.Equal(t, len(prompter.questions), 1)
+}
+
+func TestPromptCreate(t *testing.T) {
+	prompter := &fakePrompter{answers: []string{"mysource", "ksvc:foo"}}
+	root, calls := newPromptTestCommands(&KnParams{Input: true, NewPrompter: prompter.new})
+
+	root.SetArgs([]string{"source", "ping", "create"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["source ping create"], []string{"mysource", "sink=ksvc:foo"})
+	assert.DeepEqual(t, prompter.questions, []string{
+		"Name of the new ping source:",
+		"Sink to send the events to, like ksvc:NAME, broker:NAME or a URL (--sink):",
+	})
+
+	prompter = &fakePrompter{answers: []string{"nginx"}}
+	root, calls = newPromptTestCommands(&KnParams{Input: true, NewPrompter: prompter.new})
+	root.SetArgs([]string{"service", "create", "foo"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service create"], []string{"foo", "image=nginx"})
+
+	// image isn't required when creating the service from a file
+	root, calls = newPromptTestCommands(&KnParams{Input: true, NewPrompter: prompter.new})
+	root.SetArgs([]string{"service", "create", "foo", "--filename", "foo.yaml"})
+	assert.NilError(t, root.Execute())
+	assert.DeepEqual(t, calls["service create"], []string{"foo", "image="})
+	assert.Equal(t, len(prompter.questions), 1)
+}
+
+func TestPromptDisabled(t *testing.T) {
+	for _, params := range []*KnParams{
+		{Input: false, NewPrompter: (&fakePrompter{}).new},
+		{Input: true, NewPrompter: func(cmd *cobra.Command) (Prompter, error) {
+			return nil, tui.ErrNotInteractive
+		}},
+		{Input: true},
+	} {
+		root, _ := newPromptTestCommands(params)
+		root.SetArgs([]string{"source", "ping", "create"})
+		assert.ErrorContains(t, root.Execute(), `required flag(s) "sink" not set`)
+	}
+}
+
+func TestPromptAborted(t *testing.T) {
+	prompter := &fakePrompter{}
+	root, calls := newPromptTestCommands(&KnParams{Input: true, NewPrompter: prompter.new})
+	root.SetArgs([]string{"service", "delete"})
+	assert.ErrorContains(t, root.Execute(), "prompt aborted")
+	_, called := calls["service delete"]
+	assert.Assert(t, !called)
+}
+
+func TestNewPrompter(t *testing.T) {
+	p := &KnParams{}
+	p.Initialize()
+	_, err := p.NewPrompter(&cobra.Command{})
+	assert.Assert(t, errors.Is(err, tui.ErrNotInteractive))
+}
+
+type fakePrompter struct {
+	answers   []string
+	questions []string
+}
+
+func (f *fakePrompter) new(cmd *cobra.Command) (Prompter, error) {
+	return f, nil
+}
+
+func (f *fakePrompter) Choose(options []string, format string, a ...any) (string, error) {
+	return f.answer(fmt.Sprintf(format, a...) + fmt.Sprintf(" %v", options))
+}
+
+func (f *fakePrompter) Input(format string, a ...any) (string, error) {
+	return f.answer(fmt.Sprintf(format, a...))
+}
+
+func (f *fakePrompter) answer(question string) (string, error) {
+	f.questions = append(f.questions, question)
+	if len(f.answers) == 0 {
+		return "", errors.New("prompt aborted")
+	}
+	answer := f.answers[0]
+	f.answers = f.answers[1:]
+	return answer, nil
+}
+
+// newPromptTestCommands returns a command tree with interactive prompts, and the arguments and
+// flags the commands have been called with
+func isBoolFlag(flag string) bool {
+	return flag == "prune-all" || flag == "all"
+}
+
+func newPromptTestCommands(p *KnParams) (*cobra.Command, map[string][]string) {
+	calls := map[string][]string{}
+	leaf := func(use string, flags ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: use}
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			call := append([]string{}, args...)
+			for _, flag := range flags {
+				if !isBoolFlag(flag) {
+					call = append(call, flag+"="+cmd.Flag(flag).Value.String())
+				}
+			}
+			calls[commandPath(cmd)] = call
+			return nil
+		}
+		for _, flag := range flags {
+			if isBoolFlag(flag) {
+				cmd.Flags().Bool(flag, false, "Usage of "+flag+".")
+				continue
+			}
+			cmd.Flags().String(flag, "", "Usage of "+flag+".")
+		}
+		return cmd
+	}
+
+	deleteCmd := leaf("delete NAME", "prune-all", "all")
+	deleteCmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"foo", "bar"}, cobra.ShellCompDirectiveNoFileComp
+	}
+	createCmd := leaf("create NAME --image IMAGE", "image")
+	createCmd.Flags().String("filename", "", "Create from file.")
+	service := &cobra.Command{Use: "service"}
+	service.AddCommand(deleteCmd, createCmd, leaf("list NAME"))
+
+	pingCreate := leaf("create NAME --sink SINK", "sink")
+	pingCreate.MarkFlagRequired("sink")
+	ping := &cobra.Command{Use: "ping"}
+	ping.AddCommand(pingCreate)
+	source := &cobra.Command{Use: "source"}
+	source.AddCommand(ping)
+
+	root := &cobra.Command{Use: "kn", SilenceErrors: true, SilenceUsage: true}
+	root.AddCommand(service, source)
+	AddInteractivePrompts(root, p)
+	return root, calls
+}
@@ -0,0 +1,353 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/printers"
+	clientserving "knative.dev/client/pkg/serving"
+)
+
+// revisionDiff holds the differences between the configuration of two revisions
+type revisionDiff struct {
+	From    string           `json:"from"`
+	To      string           `json:"to"`
+	Changes []revisionChange `json:"changes"`
+}
+
+// revisionChange is a single difference, with an empty value for a field which isn't set
+type revisionChange struct {
+	Section string `json:"section"`
+	Field   string `json:"field"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+}
+
+// NewRevisionDiffCommand represents 'kn revision diff' command
+func NewRevisionDiffCommand(p *commands.KnParams) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "diff REV1 REV2",
+		Short: "Show the differences between two revisions",
+		Long: `Show the differences between two revisions
+
+The containers, environment variables, resources, scaling settings, volumes and
+the remaining annotations and labels of the revisions are compared. Metadata
+maintained by Knative itself is ignored.`,
+		Example: `
+  # Show what changed from revision 'svc1-00001' to 'svc1-00002'
+  kn revision diff svc1-00001 svc1-00002
+
+  # Show the differences in JSON format
+  kn revision diff svc1-00001 svc1-00002 -o json`,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("'kn revision diff' requires the names of two revisions as arguments")
+			}
+			format := cmd.Flag("output").Value.String()
+			switch format {
+			case "", "json", "JSON", "yaml", "YAML":
+			default:
+				return fmt.Errorf("invalid value for output flag, choose one among 'json' or 'yaml'")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			var revisions [2]*servingv1.Revision
+			for i, name := range args {
+				if revisions[i], err = client.GetRevision(cmd.Context(), name); err != nil {
+					return err
+				}
+			}
+			diff := diffRevisions(revisions[0], revisions[1])
+
+			out := cmd.OutOrStdout()
+			switch strings.ToLower(format) {
+			case "json":
+				b, err := json.MarshalIndent(diff, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, string(b))
+			case "yaml":
+				b, err := yaml.Marshal(diff)
+				if err != nil {
+					return err
+				}
+				fmt.Fprint(out, string(b))
+			default:
+				printRevisionDiff(out, diff)
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringP("output", "o", "", "Output format. One of: json|yaml.")
+	return command
+}
+
+// diffRevisions compares the configuration of the revisions, after removing the metadata
+// maintained by Knative
+func diffRevisions(from, to *servingv1.Revision) *revisionDiff {
+	diff := &revisionDiff{From: from.Name, To: to.Name, Changes: []revisionChange{}}
+	from, to = normalizeRevision(from), normalizeRevision(to)
+
+	fromContainers, toContainers := containersByName(from), containersByName(to)
+	for _, name := range sortedKeys(fromContainers, toContainers) {
+		diff.diffContainer(name, fromContainers[name], toContainers[name])
+	}
+
+	fromScaling, fromMetadata := splitScalingAnnotations(from.Annotations)
+	toScaling, toMetadata := splitScalingAnnotations(to.Annotations)
+	diff.add("scaling", "containerConcurrency", int64Value(from.Spec.ContainerConcurrency), int64Value(to.Spec.ContainerConcurrency))
+	diff.diffMaps("scaling", "", fromScaling, toScaling)
+
+	fromVolumes, toVolumes := volumesByName(from), volumesByName(to)
+	for _, name := range sortedKeys(fromVolumes, toVolumes) {
+		diff.add("volumes", name, fromVolumes[name], toVolumes[name])
+	}
+
+	diff.add("spec", "serviceAccountName", from.Spec.ServiceAccountName, to.Spec.ServiceAccountName)
+	diff.add("spec", "timeoutSeconds", int64Value(from.Spec.TimeoutSeconds), int64Value(to.Spec.TimeoutSeconds))
+	diff.diffMaps("annotations", "", fromMetadata, toMetadata)
+	diff.diffMaps("labels", "", withoutKnativeKeys(from.Labels), withoutKnativeKeys(to.Labels))
+	return diff
+}
+
+// normalizeRevision returns a copy of the revision without the annotations and labels which
+// are ignored when exporting revisions
+func normalizeRevision(revision *servingv1.Revision) *servingv1.Revision {
+	revision = revision.DeepCopy()
+	for _, annotation := range clientserving.IgnoredRevisionAnnotations {
+		delete(revision.Annotations, annotation)
+	}
+	for _, label := range clientserving.IgnoredRevisionLabels {
+		delete(revision.Labels, label)
+	}
+	// the image is compared with the containers
+	delete(revision.Annotations, clientserving.UserImageAnnotationKey)
+	return revision
+}
+
+func (d *revisionDiff) diffContainer(name string, from, to *corev1.Container) {
+	if from == nil || to == nil {
+		d.add("containers", name, containerImage(from), containerImage(to))
+		return
+	}
+	d.add("containers", name+".image", from.Image, to.Image)
+	d.add("containers", name+".command", strings.Join(from.Command, " "), strings.Join(to.Command, " "))
+	d.add("containers", name+".args", strings.Join(from.Args, " "), strings.Join(to.Args, " "))
+	d.add("containers", name+".workingDir", from.WorkingDir, to.WorkingDir)
+	d.add("containers", name+".ports", containerPorts(from), containerPorts(to))
+	d.add("containers", name+".envFrom", jsonValue(from.EnvFrom), jsonValue(to.EnvFrom))
+	d.add("containers", name+".volumeMounts", volumeMounts(from), volumeMounts(to))
+	d.add("containers", name+".readinessProbe", jsonValue(from.ReadinessProbe), jsonValue(to.ReadinessProbe))
+	d.add("containers", name+".livenessProbe", jsonValue(from.LivenessProbe), jsonValue(to.LivenessProbe))
+
+	d.diffMaps("env", name+".", envValues(from), envValues(to))
+	d.diffMaps("resources", name+".requests.", resourceValues(from.Resources.Requests), resourceValues(to.Resources.Requests))
+	d.diffMaps("resources", name+".limits.", resourceValues(from.Resources.Limits), resourceValues(to.Resources.Limits))
+}
+
+// diffMaps adds the differing values of the maps, with the keys prefixed for the field names
+func (d *revisionDiff) diffMaps(section, prefix string, from, to map[string]string) {
+	for _, key := range sortedKeys(from, to) {
+		d.add(section, prefix+key, from[key], to[key])
+	}
+}
+
+func (d *revisionDiff) add(section, field, from, to string) {
+	if from != to {
+		d.Changes = append(d.Changes, revisionChange{Section: section, Field: field, From: from, To: to})
+	}
+}
+
+// printRevisionDiff prints the differences as a table, with the revision names as column headers
+func printRevisionDiff(out io.Writer, diff *revisionDiff) {
+	if len(diff.Changes) == 0 {
+		fmt.Fprintf(out, "No differences between revision '%s' and revision '%s'.\n", diff.From, diff.To)
+		return
+	}
+	w := printers.NewTabWriter(out)
+	fmt.Fprintf(w, "SECTION\tFIELD\t%s\t%s\n", strings.ToUpper(diff.From), strings.ToUpper(diff.To))
+	for _, change := range diff.Changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", change.Section, change.Field, valueOrNone(change.From), valueOrNone(change.To))
+	}
+	w.Flush()
+}
+
+func containersByName(revision *servingv1.Revision) map[string]*corev1.Container {
+	containers := map[string]*corev1.Container{}
+	for i := range revision.Spec.Containers {
+		container := &revision.Spec.Containers[i]
+		name := container.Name
+		if name == "" {
+			name = fmt.Sprintf("container[%d]", i)
+		}
+		containers[name] = container
+	}
+	return containers
+}
+
+func containerImage(container *corev1.Container) string {
+	if container == nil {
+		return ""
+	}
+	return container.Image
+}
+
+func containerPorts(container *corev1.Container) string {
+	var ports []string
+	for _, port := range container.Ports {
+		value := strconv.Itoa(int(port.ContainerPort))
+		if port.Name != "" {
+			value = port.Name + ":" + value
+		}
+		ports = append(ports, value)
+	}
+	return strings.Join(ports, ",")
+}
+
+func volumeMounts(container *corev1.Container) string {
+	var mounts []string
+	for _, mount := range container.VolumeMounts {
+		value := mount.Name + ":" + mount.MountPath
+		if mount.ReadOnly {
+			value += ":ro"
+		}
+		mounts = append(mounts, value)
+	}
+	return strings.Join(mounts, ",")
+}
+
+// envValues returns the environment variables of the container, with references to
+// config maps and secrets rendered as JSON
+func envValues(container *corev1.Container) map[string]string {
+	env := map[string]string{}
+	for _, e := range container.Env {
+		if e.ValueFrom != nil {
+			env[e.Name] = jsonValue(e.ValueFrom)
+			continue
+		}
+		env[e.Name] = e.Value
+	}
+	return env
+}
+
+func resourceValues(resources corev1.ResourceList) map[string]string {
+	values := map[string]string{}
+	for name, quantity := range resources {
+		values[string(name)] = quantity.String()
+	}
+	return values
+}
+
+func volumesByName(revision *servingv1.Revision) map[string]string {
+	volumes := map[string]string{}
+	for _, volume := range revision.Spec.Volumes {
+		volumes[volume.Name] = jsonValue(volume.VolumeSource)
+	}
+	return volumes
+}
+
+// splitScalingAnnotations splits the annotations into the autoscaling ones and the others
+func splitScalingAnnotations(annotations map[string]string) (map[string]string, map[string]string) {
+	scaling, others := map[string]string{}, map[string]string{}
+	for key, value := range annotations {
+		if strings.HasPrefix(key, autoscaling.GroupName+"/") {
+			scaling[key] = value
+		} else if !isKnativeKey(key) {
+			others[key] = value
+		}
+	}
+	return scaling, others
+}
+
+func withoutKnativeKeys(labels map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, value := range labels {
+		if !isKnativeKey(key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// isKnativeKey returns true for annotations and labels set by Knative for each revision,
+// like its generation or routing state
+func isKnativeKey(key string) bool {
+	return strings.HasPrefix(key, "serving.knative.dev/")
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func int64Value(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+// jsonValue renders the value as compact JSON, or returns an empty string for an empty value
+func jsonValue(value any) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	switch s := string(b); s {
+	case "null", "{}", "[]":
+		return ""
+	default:
+		return s
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
@@ -0,0 +1,161 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestRevisionDiff(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	from, to := newDiffRevisions()
+	r.GetRevision("foo-00001", from, nil)
+	r.GetRevision("foo-00002", to, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "foo-00001", "foo-00002")
+	assert.NilError(t, err)
+	lines := strings.Split(output, "\n")
+	assert.Assert(t, util.ContainsAll(lines[0], "SECTION", "FIELD", "FOO-00001", "FOO-00002"))
+	assert.Assert(t, util.ContainsAll(output,
+		"user-container.image", "nginx:1", "nginx:2",
+		"user-container.LOG_LEVEL", "info", "<none>",
+		"user-container.TOKEN", `{"secretKeyRef":{"name":"token","key":"value"}}`,
+		"user-container.limits.memory", "256Mi", "512Mi",
+		"autoscaling.knative.dev/max-scale", "3",
+		"containerConcurrency", "10",
+		"config", `{"configMap":{"name":"config"}}`,
+		"team", "a", "b"))
+	// metadata maintained by Knative and unchanged values aren't compared
+	assert.Assert(t, util.ContainsNone(output, "creator", "lastPinned", "configurationGeneration", "user-image", "FOO ", "requests"))
+	r.Validate()
+}
+
+func TestRevisionDiffJSON(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	from, to := newDiffRevisions()
+	r.GetRevision("foo-00001", from, nil)
+	r.GetRevision("foo-00002", to, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "foo-00001", "foo-00002", "-o", "json")
+	assert.NilError(t, err)
+	var diff revisionDiff
+	assert.NilError(t, json.Unmarshal([]byte(output), &diff))
+	assert.Equal(t, diff.From, "foo-00001")
+	assert.Equal(t, diff.To, "foo-00002")
+	assert.DeepEqual(t, diff.Changes[0], revisionChange{Section: "containers", Field: "user-container.image", From: "nginx:1", To: "nginx:2"})
+	assert.Assert(t, slices.Contains(diff.Changes, revisionChange{Section: "env", Field: "user-container.LOG_LEVEL", From: "info"}))
+	assert.Assert(t, slices.Contains(diff.Changes, revisionChange{Section: "scaling", Field: "containerConcurrency", To: "10"}))
+	r.Validate()
+}
+
+func TestRevisionDiffIdentical(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	from, _ := newDiffRevisions()
+	same := from.DeepCopy()
+	same.Name = "foo-00002"
+	same.Labels["serving.knative.dev/configurationGeneration"] = "2"
+	r.GetRevision("foo-00001", from, nil)
+	r.GetRevision("foo-00002", same, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "foo-00001", "foo-00002")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "No differences between revision 'foo-00001' and revision 'foo-00002'.\n")
+	r.Validate()
+}
+
+func TestRevisionDiffErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetRevision("foo-00001", nil, errors.New("revisions.serving.knative.dev \"foo-00001\" not found"))
+
+	_, err := executeRevisionCommand(client, "diff", "foo-00001")
+	assert.ErrorContains(t, err, "requires the names of two revisions")
+	_, err = executeRevisionCommand(client, "diff", "foo-00001", "foo-00002", "-o", "xml")
+	assert.ErrorContains(t, err, "invalid value for output flag")
+	_, err = executeRevisionCommand(client, "diff", "foo-00001", "foo-00002")
+	assert.ErrorContains(t, err, "not found")
+	r.Validate()
+}
+
+func newDiffRevisions() (*servingv1.Revision, *servingv1.Revision) {
+	from := &servingv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo-00001",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"serving.knative.dev/creator":    "alice",
+				"serving.knative.dev/lastPinned": "1700000000",
+				"client.knative.dev/user-image":  "nginx:1",
+			},
+			Labels: map[string]string{
+				"serving.knative.dev/configurationGeneration": "1",
+				"team": "a",
+			},
+		},
+		Spec: servingv1.RevisionSpec{
+			PodSpec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  "user-container",
+					Image: "nginx:1",
+					Env: []corev1.EnvVar{
+						{Name: "FOO", Value: "bar"},
+						{Name: "LOG_LEVEL", Value: "info"},
+					},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					},
+				}},
+			},
+		},
+	}
+	to := from.DeepCopy()
+	to.Name = "foo-00002"
+	to.Annotations = map[string]string{
+		"serving.knative.dev/creator":       "bob",
+		"client.knative.dev/user-image":     "nginx:2",
+		"autoscaling.knative.dev/max-scale": "3",
+	}
+	to.Labels = map[string]string{"serving.knative.dev/configurationGeneration": "2", "team": "b"}
+	to.Spec.ContainerConcurrency = ptr.Int64(10)
+	container := &to.Spec.Containers[0]
+	container.Image = "nginx:2"
+	container.Env = []corev1.EnvVar{
+		{Name: "FOO", Value: "bar"},
+		{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "token"}, Key: "value"}}},
+	}
+	container.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")}
+	to.Spec.Volumes = []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}}}}
+	return from, to
+}
@@ -24,6 +24,7 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/commands"
@@ -54,7 +55,10 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
   kn revision list -o json
 
   # List revision 'web'
-  kn revision list web`,
+  kn revision list web
+
+  # List the revisions of service 'svc1' and watch for changes while it is updated
+  kn revision list -s svc1 --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -82,10 +86,12 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			// Stop if nothing found
+			// Stop if nothing found, unless waiting for revisions to show up
 			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() && len(revisionList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No revisions found.\n")
-				return nil
+				if !revisionListFlags.Watch {
+					return nil
+				}
 			}
 
 			// Add namespace column if no namespace is given (i.e. "--all-namespaces" option is given)
@@ -104,12 +110,27 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			// Sort revisions by namespace, service, generation (in this order)
 			sortRevisions(revisionList)
 
+			if revisionListFlags.Watch {
+				enrich := !revisionListFlags.GenericPrintFlags.OutputFlagSpecified()
+				return revisionListFlags.PrintWatch(cmd.Context(), revisionList, func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+					watcher, err := client.WatchRevisions(ctx, resourceVersion, params...)
+					if err != nil || !enrich {
+						return watcher, err
+					}
+					return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+						enrichWatchedRevision(ctx, p.NewServingClient, event)
+						return event, true
+					}), nil
+				}, cmd.OutOrStdout())
+			}
+
 			// Print out infos via printer framework
 			return revisionListFlags.Print(revisionList, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(revisionListCommand.Flags(), true)
 	revisionListFlags.AddFlags(revisionListCommand)
+	revisionListFlags.AddWatchFlag(revisionListCommand)
 	revisionListCommand.Flags().StringVarP(&serviceNameFilter, "service", "s", "", "Service name")
 
 	return revisionListCommand
@@ -223,6 +244,21 @@ func enrichRevisionAnnotationsWithServiceData(ctx context.Context, serviceFactor
 
 }
 
+// enrichWatchedRevision adds the traffic and tags to a revision reported by a watch. The
+// service is looked up for each revision, as its traffic might have changed meanwhile. A
+// revision whose service can't be found anymore, e.g. as it's being deleted, is left as is.
+func enrichWatchedRevision(ctx context.Context, serviceFactory serviceFactoryFunc, event watch.Event) {
+	revision, ok := event.Object.(*servingv1.Revision)
+	if !ok || event.Type == watch.Deleted {
+		return
+	}
+	if revision.Annotations == nil {
+		revision.Annotations = map[string]string{}
+	}
+	// the annotations map is shared with the list's copy of the revision
+	_ = enrichRevisionAnnotationsWithServiceData(ctx, serviceFactory, &servingv1.RevisionList{Items: []servingv1.Revision{*revision}})
+}
+
 // Create a function for being able to lookup a service for an arbitrary namespace
 func serviceLookup(ctx context.Context, serviceFactory serviceFactoryFunc) serviceGetFunc {
 
@@ -15,13 +15,17 @@
 package revision
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -213,3 +217,36 @@ func createMockRevisionWithParams(name, svcName, generation, traffic, tags strin
 	}
 	return revision
 }
+
+func TestRevisionListWatch(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	fakeServing.AddReactor("list", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &servingv1.RevisionList{ListMeta: metav1.ListMeta{ResourceVersion: "5"}}, nil
+		})
+	fakeServing.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+			service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: "foo-00001", Percent: ptr.Int64(100), Tag: "current"}}
+			return true, service, nil
+		})
+	watcher := watch.NewFakeWithChanSize(2, false)
+	revision := createMockRevisionWithParams("foo-00001", "foo", "1", "", "")
+	revision.Annotations = nil
+	watcher.Add(revision)
+	watcher.Error(&apierrors.NewForbidden(servingv1.Resource("revisions"), "", errors.New("not allowed")).ErrStatus)
+	fakeServing.AddWatchReactor("revisions",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			assert.Equal(t, a.(clienttesting.WatchAction).GetWatchRestrictions().ResourceVersion, "5")
+			return true, watcher, nil
+		})
+
+	cmd.SetArgs([]string{"revision", "list", "--watch"})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "not allowed")
+	output := strings.Split(buf.String(), "\n")
+	assert.Equal(t, output[0], "No revisions found.")
+	assert.Check(t, util.ContainsAll(output[1], revisionListHeader...))
+	assert.Check(t, util.ContainsAll(output[2], "foo-00001", "foo", "100%", "current"))
+}
@@ -0,0 +1,365 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// pruneOptions select the revisions to prune
+type pruneOptions struct {
+	keep          int
+	olderThan     time.Duration
+	excludeTagged bool
+}
+
+// pruneCandidate is a revision to prune, with the tags to remove from the traffic of its service
+type pruneCandidate struct {
+	revision servingv1.Revision
+	service  string
+	tags     []string
+}
+
+// NewRevisionPruneCommand represents 'kn revision prune' command
+func NewRevisionPruneCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var dryRunFlags commands.DryRunFlags
+	var opts pruneOptions
+	var serviceName, olderThan string
+	var all bool
+	var concurrency int
+
+	command := &cobra.Command{
+		Use:   "prune --service SERVICE | --all",
+		Short: "Delete revisions which are no longer needed",
+		Long: `Delete revisions which are no longer needed
+
+Revisions receiving traffic, and the latest revisions of a service, are never
+deleted. All other revisions are deleted, apart from the newest ones kept with
+--keep, the ones younger than --older-than and, with --exclude-tagged, the ones
+reachable by a tag. Tags of deleted revisions are removed from the traffic of
+their service.
+
+The revisions to delete are shown before they are deleted in parallel. With
+--dry-run=client only the revisions to delete are shown, with --dry-run=server the
+deletions are validated by the API server without deleting anything.`,
+		Example: `
+  # Show which revisions of service 'mysvc' would be deleted, keeping the newest 5 revisions
+  kn revision prune --service mysvc --keep 5 --dry-run=client
+
+  # Delete all revisions in the current namespace older than a week, apart from tagged ones
+  kn revision prune --all --older-than 7d --exclude-tagged
+
+  # Delete all revisions which are no longer needed in all namespaces
+  kn revision prune --all --all-namespaces`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return errors.New("'kn revision prune' accepts no arguments")
+			}
+			if (serviceName == "") == !all {
+				return errors.New("'kn revision prune' requires either --service or --all")
+			}
+			if opts.keep < 0 {
+				return fmt.Errorf("--keep must not be negative, not %d", opts.keep)
+			}
+			if concurrency < 1 {
+				return fmt.Errorf("--concurrency must be positive, not %d", concurrency)
+			}
+			if err := dryRunFlags.Validate(cmd); err != nil {
+				return err
+			}
+			cmd.SetContext(dryRunFlags.WithContext(cmd.Context()))
+			if olderThan != "" {
+				var err error
+				if opts.olderThan, err = parseAge(olderThan); err != nil {
+					return fmt.Errorf("invalid value '%s' for --older-than: %w", olderThan, err)
+				}
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			if namespace == "" && serviceName != "" {
+				return errors.New("--service can't be used together with --all-namespaces")
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			var services []servingv1.Service
+			var params []clientservingv1.ListConfig
+			if serviceName != "" {
+				service, err := client.GetService(cmd.Context(), serviceName)
+				if err != nil {
+					return err
+				}
+				services = []servingv1.Service{*service}
+				params = append(params, clientservingv1.WithService(serviceName))
+			} else {
+				serviceList, err := client.ListServices(cmd.Context())
+				if err != nil {
+					return err
+				}
+				services = serviceList.Items
+			}
+			revisionList, err := client.ListRevisions(cmd.Context(), params...)
+			if err != nil {
+				return err
+			}
+
+			candidates := pruneCandidates(services, revisionList, opts, time.Now())
+			out := cmd.OutOrStdout()
+			if len(candidates) == 0 {
+				fmt.Fprintln(out, "No revisions to prune.")
+				return nil
+			}
+			printPrunePlan(out, candidates, namespace == "")
+			fmt.Fprintln(out)
+			mode, _ := dryRunFlags.Mode()
+			if mode == clientservingv1.DryRunClient {
+				fmt.Fprintf(out, "%d revision(s) would be deleted (%s dry run).\n", len(candidates), dryRunFlags.DryRun)
+				return nil
+			}
+
+			timeout := time.Duration(0)
+			if waitFlags.Wait {
+				timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
+			}
+			errs := pruneRevisions(cmd.Context(), p.NewServingClient, candidates, timeout, concurrency)
+			for i, candidate := range candidates {
+				if errs[i] != nil {
+					continue
+				}
+				message := fmt.Sprintf("Revision '%s' deleted in namespace '%s'", candidate.revision.Name, candidate.revision.Namespace)
+				if dryRunFlags.Enabled() {
+					fmt.Fprintf(out, "%s (%s dry run).\n", message, dryRunFlags.DryRun)
+				} else {
+					fmt.Fprintln(out, message+".")
+				}
+			}
+			var messages []string
+			for _, err := range errs {
+				if err != nil {
+					messages = append(messages, err.Error())
+				}
+			}
+			if len(messages) > 0 {
+				return errors.New("Error: " + strings.Join(messages, "\nError: "))
+			}
+			return nil
+		},
+	}
+	flags := command.Flags()
+	flags.StringVarP(&serviceName, "service", "s", "", "Delete the revisions of the given service.")
+	flags.BoolVar(&all, "all", false, "Delete the revisions of all services.")
+	flags.IntVar(&opts.keep, "keep", 0, "Number of newest revisions to keep for each service.")
+	flags.StringVar(&olderThan, "older-than", "", "Only delete revisions older than the given age, like 12h or 7d.")
+	flags.BoolVar(&opts.excludeTagged, "exclude-tagged", false, "Keep revisions which are reachable by a tag.")
+	flags.IntVar(&concurrency, "concurrency", 5, "Maximum number of revisions deleted in parallel.")
+	commands.AddNamespaceFlags(flags, true)
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "delete", "revision", "deleted")
+	dryRunFlags.Add(command, "revision pruning")
+	return command
+}
+
+// pruneCandidates returns the revisions of the given services to prune, sorted by namespace,
+// service and generation. Revisions which aren't owned by one of the services are never pruned.
+func pruneCandidates(services []servingv1.Service, revisionList *servingv1.RevisionList, opts pruneOptions, now time.Time) []pruneCandidate {
+	servicesByKey := map[string]*servingv1.Service{}
+	for i := range services {
+		servicesByKey[services[i].Namespace+"/"+services[i].Name] = &services[i]
+	}
+	// Sort revisions by namespace, service, generation (in this order), so that the newest come first
+	sortRevisions(revisionList)
+
+	var candidates []pruneCandidate
+	seen := map[*servingv1.Service]int{}
+	for _, revision := range revisionList.Items {
+		serviceName := revision.Labels[serving.ServiceLabelKey]
+		service, ok := servicesByKey[revision.Namespace+"/"+serviceName]
+		if !ok {
+			continue
+		}
+		seen[service]++
+		if seen[service] <= opts.keep {
+			continue
+		}
+		if opts.olderThan > 0 && now.Sub(revision.CreationTimestamp.Time) < opts.olderThan {
+			continue
+		}
+		routed, tags := routingOfRevision(revision, service)
+		if routed || (opts.excludeTagged && len(tags) > 0) {
+			continue
+		}
+		candidates = append(candidates, pruneCandidate{revision: revision, service: serviceName, tags: tags})
+	}
+	return candidates
+}
+
+// routingOfRevision returns whether the revision receives traffic or is the latest revision of the
+// service, and the tags the revision is reachable by otherwise
+func routingOfRevision(revision servingv1.Revision, service *servingv1.Service) (bool, []string) {
+	if revision.Name == service.Status.LatestCreatedRevisionName || revision.Name == service.Status.LatestReadyRevisionName {
+		return true, nil
+	}
+	var tags []string
+	for _, targets := range [][]servingv1.TrafficTarget{service.Spec.Traffic, service.Status.Traffic} {
+		for _, target := range targets {
+			if target.RevisionName != revision.Name {
+				continue
+			}
+			if target.Percent != nil && *target.Percent > 0 {
+				return true, nil
+			}
+			if target.Tag != "" && !slices.Contains(tags, target.Tag) {
+				tags = append(tags, target.Tag)
+			}
+		}
+	}
+	// The traffic of the service might not yet reflect the routing of the revision
+	if len(tags) == 0 && revision.GetRoutingState() == servingv1.RoutingStateActive {
+		return true, nil
+	}
+	return false, tags
+}
+
+// printPrunePlan prints the revisions to prune as a table
+func printPrunePlan(out io.Writer, candidates []pruneCandidate, withNamespace bool) {
+	w := printers.NewTabWriter(out)
+	if withNamespace {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
+	fmt.Fprintln(w, "SERVICE\tREVISION\tGENERATION\tAGE\tTAGS")
+	for _, candidate := range candidates {
+		if withNamespace {
+			fmt.Fprintf(w, "%s\t", candidate.revision.Namespace)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			candidate.service,
+			candidate.revision.Name,
+			candidate.revision.Labels[serving.ConfigurationGenerationLabelKey],
+			commands.TranslateTimestampSince(candidate.revision.CreationTimestamp),
+			strings.Join(candidate.tags, ","))
+	}
+	w.Flush()
+}
+
+// pruneRevisions deletes the revisions, with at most concurrency deletions running in parallel.
+// Tags referencing the revisions are removed from their services first. The returned errors
+// correspond to the given revisions.
+func pruneRevisions(ctx context.Context, newClient func(namespace string) (clientservingv1.KnServingClient, error),
+	candidates []pruneCandidate, timeout time.Duration, concurrency int) []error {
+	errs := make([]error, len(candidates))
+
+	// Clients and removed tags per namespace and service
+	clients := map[string]clientservingv1.KnServingClient{}
+	untagErrs := map[string]error{}
+	for i, candidate := range candidates {
+		namespace := candidate.revision.Namespace
+		if _, ok := clients[namespace]; !ok {
+			client, err := newClient(namespace)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			clients[namespace] = client
+		}
+		key := namespace + "/" + candidate.service
+		if _, done := untagErrs[key]; done || len(candidate.tags) == 0 {
+			continue
+		}
+		untagErrs[key] = untagRevisions(ctx, clients[namespace], candidate.service, candidates)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, candidate := range candidates {
+		if errs[i] != nil {
+			continue
+		}
+		if err := untagErrs[candidate.revision.Namespace+"/"+candidate.service]; err != nil {
+			errs[i] = fmt.Errorf("cannot remove the tags of revision '%s' from service '%s': %w", candidate.revision.Name, candidate.service, err)
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, client clientservingv1.KnServingClient, name string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = client.DeleteRevision(ctx, name, timeout)
+		}(i, clients[candidate.revision.Namespace], candidate.revision.Name)
+	}
+	wg.Wait()
+	return errs
+}
+
+// untagRevisions removes the traffic targets of the service which only tag one of the
+// revisions to prune
+func untagRevisions(ctx context.Context, client clientservingv1.KnServingClient, serviceName string, candidates []pruneCandidate) error {
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		pruned := map[string]bool{}
+		for _, candidate := range candidates {
+			if candidate.revision.Namespace == service.Namespace && candidate.service == service.Name {
+				pruned[candidate.revision.Name] = true
+			}
+		}
+		service.Spec.Traffic = slices.DeleteFunc(service.Spec.Traffic, func(target servingv1.TrafficTarget) bool {
+			return pruned[target.RevisionName] && (target.Percent == nil || *target.Percent == 0)
+		})
+		return service, nil
+	}
+	_, err := client.UpdateServiceWithRetry(ctx, serviceName, updateFunc, config.DefaultRetry.Steps)
+	return err
+}
+
+// parseAge parses a duration, which can also be given in days like "7d"
+func parseAge(age string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(age, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid number of days '%s'", days)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	duration, err := time.ParseDuration(age)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, errors.New("age must not be negative")
+	}
+	return duration, nil
+}
@@ -0,0 +1,239 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingfake "knative.dev/serving/pkg/client/clientset/versioned/fake"
+
+	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestRevisionPrune(t *testing.T) {
+	fake := newPruneFake()
+	output, err := executePruneCommand(fake, "--service", "foo", "--keep", "1")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "SERVICE", "REVISION", "GENERATION", "AGE", "TAGS", "candidate"))
+	assert.Assert(t, util.ContainsAll(output,
+		"Revision 'foo-00004' deleted in namespace 'default'.",
+		"Revision 'foo-00003' deleted in namespace 'default'.",
+		"Revision 'foo-00001' deleted in namespace 'default'."))
+	// newest and routed revisions are kept
+	assert.Assert(t, util.ContainsNone(output, "foo-00006", "foo-00005", "foo-00002", "bar-"))
+	assert.DeepEqual(t, deletedRevisions(fake), []string{"foo-00001", "foo-00003", "foo-00004"})
+
+	// the tag of the deleted revision has been removed from the service
+	service, err := fake.ServingV1().Services("default").Get(context.Background(), "foo", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(service.Spec.Traffic), 2)
+	for _, target := range service.Spec.Traffic {
+		assert.Assert(t, target.Tag != "candidate")
+	}
+}
+
+func TestRevisionPruneDryRun(t *testing.T) {
+	fake := newPruneFake()
+	output, err := executePruneCommand(fake, "--all", "--dry-run=client", "--exclude-tagged")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo-00004", "foo-00001", "bar-00001", "3 revision(s) would be deleted (client dry run)."))
+	assert.Assert(t, util.ContainsNone(output, "foo-00003", "deleted in namespace"))
+	assert.Equal(t, len(deletedRevisions(fake)), 0)
+
+	output, err = executePruneCommand(fake, "--all", "--dry-run=client", "--older-than", "3d")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo-00003", "foo-00001", "2 revision(s) would be deleted"))
+	assert.Assert(t, util.ContainsNone(output, "foo-00004", "bar-00001"))
+
+	output, err = executePruneCommand(fake, "--all", "--all-namespaces", "--dry-run=client", "--keep", "6")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No revisions to prune."))
+}
+
+func TestRevisionPruneServerDryRun(t *testing.T) {
+	fake := newPruneFake()
+	output, err := executePruneCommand(fake, "--service", "foo", "--keep", "1", "--dry-run=server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output,
+		"Revision 'foo-00004' deleted in namespace 'default' (server dry run).",
+		"Revision 'foo-00001' deleted in namespace 'default' (server dry run)."))
+	deletions := 0
+	for _, action := range fake.Actions() {
+		switch action := action.(type) {
+		case clienttesting.DeleteAction:
+			assert.DeepEqual(t, action.(clienttesting.DeleteActionImpl).DeleteOptions.DryRun, []string{metav1.DryRunAll})
+			deletions++
+		case clienttesting.WatchAction:
+			t.Fatal("no deletion is waited for in a dry run")
+		}
+	}
+	assert.Equal(t, deletions, 3)
+}
+
+func TestRevisionPruneAllNamespaces(t *testing.T) {
+	fake := newPruneFake()
+	output, err := executePruneCommand(fake, "--all", "-A", "--dry-run=client")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "NAMESPACE", "other", "baz-00001", "default", "foo-00001"))
+}
+
+func TestRevisionPruneErrors(t *testing.T) {
+	fake := newPruneFake()
+	fake.PrependReactor("delete", "revisions", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		if a.(clienttesting.DeleteAction).GetName() == "foo-00003" {
+			return true, nil, errors.New("admission webhook denied the request")
+		}
+		return false, nil, nil
+	})
+	output, err := executePruneCommand(fake, "--all", "--concurrency", "1")
+	assert.ErrorContains(t, err, "admission webhook denied the request")
+	assert.Assert(t, util.ContainsAll(output, "Revision 'foo-00004' deleted", "Revision 'foo-00001' deleted", "Revision 'bar-00001' deleted"))
+	assert.Assert(t, util.ContainsNone(output, "Revision 'foo-00003' deleted"))
+	assert.DeepEqual(t, deletedRevisions(fake), []string{"bar-00001", "foo-00001", "foo-00003", "foo-00004"})
+}
+
+func TestRevisionPruneInvalidArgs(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{[]string{}, "requires either --service or --all"},
+		{[]string{"--all", "--service", "foo"}, "requires either --service or --all"},
+		{[]string{"--all", "foo"}, "accepts no arguments"},
+		{[]string{"--all", "--keep", "-1"}, "--keep must not be negative"},
+		{[]string{"--all", "--concurrency", "0"}, "--concurrency must be positive"},
+		{[]string{"--all", "--older-than", "a week"}, "invalid value 'a week' for --older-than"},
+		{[]string{"--service", "foo", "-A"}, "can't be used together with --all-namespaces"},
+		{[]string{"--service", "unknown"}, "not found"},
+		{[]string{"--all", "--dry-run", "yes"}, "invalid value 'yes' for '--dry-run'"},
+	} {
+		_, err := executePruneCommand(newPruneFake(), tc.args...)
+		assert.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	for age, expected := range map[string]time.Duration{
+		"7d":    7 * 24 * time.Hour,
+		"0d":    0,
+		"12h":   12 * time.Hour,
+		"1h30m": 90 * time.Minute,
+	} {
+		actual, err := parseAge(age)
+		assert.NilError(t, err)
+		assert.Equal(t, actual, expected)
+	}
+	for _, age := range []string{"d", "-1d", "xd", "-5m", "7"} {
+		_, err := parseAge(age)
+		assert.Assert(t, err != nil, age)
+	}
+}
+
+// newPruneFake returns a clientset with service 'foo' having six revisions, of which the
+// latest two and the second receive traffic and the third is tagged, and services 'bar'
+// and 'baz' with one unreferenced revision each
+func newPruneFake() *servingfake.Clientset {
+	foo := newPruneService("default", "foo", "foo-00006")
+	foo.Spec.Traffic = []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(90)},
+		{RevisionName: "foo-00005", Percent: ptr.Int64(10)},
+		{RevisionName: "foo-00003", Percent: ptr.Int64(0), Tag: "candidate"},
+	}
+	foo.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00006", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(90)},
+		{RevisionName: "foo-00005", Percent: ptr.Int64(10)},
+		{RevisionName: "foo-00003", Percent: ptr.Int64(0), Tag: "candidate"},
+	}
+	objects := []runtime.Object{
+		foo,
+		newPruneService("default", "bar", "bar-00002"),
+		newPruneService("other", "baz", "baz-00002"),
+		newPruneRevision("default", "bar", 1, 0),
+		newPruneRevision("default", "bar", 2, 0),
+		newPruneRevision("other", "baz", 1, 0),
+		newPruneRevision("other", "baz", 2, 0),
+	}
+	for generation := 1; generation <= 6; generation++ {
+		objects = append(objects, newPruneRevision("default", "foo", generation, 6-generation))
+	}
+	// routed, but not yet reflected in the traffic of the service
+	active := newPruneRevision("default", "foo", 2, 4)
+	active.Labels[serving.RoutingStateLabelKey] = string(servingv1.RoutingStateActive)
+	objects[len(objects)-5] = active
+	return servingfake.NewSimpleClientset(objects...)
+}
+
+func newPruneService(namespace, name, latest string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	service.Spec.Traffic = []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	service.Status.LatestCreatedRevisionName = latest
+	service.Status.LatestReadyRevisionName = latest
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: latest, LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	return service
+}
+
+func newPruneRevision(namespace, service string, generation, days int) *servingv1.Revision {
+	return &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{
+		Name:      service + "-0000" + strconv.Itoa(generation),
+		Namespace: namespace,
+		Labels: map[string]string{
+			serving.ServiceLabelKey:                 service,
+			serving.ConfigurationGenerationLabelKey: strconv.Itoa(generation),
+		},
+		CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Duration(days)*24*time.Hour - time.Hour)),
+	}}
+}
+
+func executePruneCommand(fake *servingfake.Clientset, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return clientservingv1.NewKnServingClient(fake.ServingV1(), namespace), nil
+	}
+	output := new(bytes.Buffer)
+	cmd := NewRevisionCommand(knParams)
+	cmd.SetArgs(append([]string{"prune"}, args...))
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+// deletedRevisions returns the sorted names of the deleted revisions
+func deletedRevisions(fake *servingfake.Clientset) []string {
+	var names []string
+	for _, action := range fake.Actions() {
+		if action.Matches("delete", "revisions") {
+			names = append(names, action.(clienttesting.DeleteAction).GetName())
+		}
+	}
+	slices.Sort(names)
+	return names
+}
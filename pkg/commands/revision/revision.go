@@ -30,6 +30,8 @@ func NewRevisionCommand(p *commands.KnParams) *cobra.Command {
 	revisionCmd.AddCommand(NewRevisionListCommand(p))
 	revisionCmd.AddCommand(NewRevisionDescribeCommand(p))
 	revisionCmd.AddCommand(NewRevisionDeleteCommand(p))
+	revisionCmd.AddCommand(NewRevisionPruneCommand(p))
+	revisionCmd.AddCommand(NewRevisionDiffCommand(p))
 	return revisionCmd
 }
 
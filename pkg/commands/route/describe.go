@@ -24,16 +24,27 @@ import (
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/output/term"
 	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/traffic"
 )
 
+var describeExample = `
+  # Describe route 'foo' in the current namespace
+  kn route describe foo
+
+  # Describe route 'foo' with a bar chart of its traffic split and the readiness of the revisions
+  kn route describe foo --traffic-graph`
+
 // NewRouteDescribeCommand represents 'kn route describe' command
 func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 	// For machine readable output
 	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+	var trafficGraph bool
 	command := &cobra.Command{
 		Use:               "describe NAME",
 		Short:             "Show details of a route",
+		Example:           describeExample,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
@@ -65,23 +76,42 @@ func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return describe(cmd.OutOrStdout(), route, printDetails)
+			var status traffic.RevisionStatus
+			if trafficGraph {
+				var revisions []servingv1.Revision
+				for _, target := range route.Status.Traffic {
+					// Readiness is only shown for the revisions which can be fetched
+					if revision, err := client.GetRevision(cmd.Context(), target.RevisionName); err == nil {
+						revisions = append(revisions, *revision)
+					}
+				}
+				status = traffic.RevisionReadiness(revisions)
+			}
+			return describe(cmd.OutOrStdout(), route, printDetails, status)
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	machineReadablePrintFlags.AddFlags(command)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.BoolVar(&trafficGraph, "traffic-graph", false, "Show the traffic split as a bar chart, with the readiness of the revisions.")
 	return command
 }
 
-func describe(w io.Writer, route *servingv1.Route, printDetails bool) error {
+// describe prints the route. The traffic is shown as a graph if the status of the revisions is given.
+func describe(w io.Writer, route *servingv1.Route, printDetails bool, status traffic.RevisionStatus) error {
 	dw := printers.NewPrefixWriter(w)
 	commands.WriteMetadata(dw, &route.ObjectMeta, printDetails)
 	dw.WriteAttribute("URL", route.Status.URL.String())
 	writeService(dw, route, printDetails)
 	dw.WriteLine()
-	writeTraffic(dw, route)
+	if status != nil {
+		trafficSection := dw.WriteAttribute("Traffic Targets", "")
+		dw.Flush()
+		traffic.ServiceTraffic(route.Status.Traffic).WriteGraph(trafficSection, status, term.IsFancy(w))
+	} else {
+		writeTraffic(dw, route)
+	}
 	dw.WriteLine()
 	commands.WriteConditions(dw, route.Status.Conditions, printDetails)
 	if err := dw.Flush(); err != nil {
@@ -16,14 +16,19 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/yaml"
 
@@ -108,6 +113,31 @@ func TestCompletion(t *testing.T) {
 			"foo", "default", "90%", "foo-v2", "#v2", "10%", "@latest", "foo-v3"))
 	})
 
+	t.Run("describe a route with a traffic graph", func(t *testing.T) {
+		setup(t)
+
+		knParams := &commands.KnParams{}
+		cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+		fakeServing.AddReactor("get", "routes", func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &expectedRoute, nil
+		})
+		fakeServing.AddReactor("get", "revisions", func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			if name == "foo-v3" {
+				return true, nil, errors.New("revisions.serving.knative.dev \"foo-v3\" not found")
+			}
+			revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+			revision.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+			return true, revision, nil
+		})
+		cmd.SetArgs([]string{"route", "describe", "foo", "--traffic-graph"})
+		assert.NilError(t, cmd.Execute())
+
+		output := buf.String()
+		assert.Assert(t, cmp.Regexp(`(?m)^\s+##################\.\.\s+90%\s+foo-v2 #v2\s+Ready$`, output))
+		assert.Assert(t, cmp.Regexp(`(?m)^\s+##\.{18}\s+10%\s+@latest \(foo-v3\) #latest\s*$`, output))
+	})
+
 	t.Run("describe a route with verbose output", func(t *testing.T) {
 		_, output, err := fakeRouteDescribe([]string{"route", "describe", "foo", "-v"}, &expectedRoute)
 		assert.Assert(t, err == nil)
@@ -15,6 +15,7 @@
 package route
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -22,7 +23,7 @@ import (
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 
 	"github.com/spf13/cobra"
-	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"knative.dev/client/pkg/commands/flags"
 )
@@ -42,7 +43,10 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
   kn route list web -n dev
 
   # List all routes in YAML format
-  kn route list -o yaml`,
+  kn route list -o yaml
+
+  # List all routes and watch for changes, e.g. to see them becoming ready
+  kn route list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			namespace, err := p.GetNamespace(cmd)
@@ -54,21 +58,28 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			var routeList *servingv1.RouteList
+			var listConfig []clientservingv1.ListConfig
 			switch len(args) {
 			case 0:
-				routeList, err = client.ListRoutes(cmd.Context())
 			case 1:
-				routeList, err = client.ListRoutes(cmd.Context(), clientservingv1.WithName(args[0]))
+				listConfig = append(listConfig, clientservingv1.WithName(args[0]))
 			default:
 				return errors.New("'kn route list' accepts only one additional argument")
 			}
+			routeList, err := client.ListRoutes(cmd.Context(), listConfig...)
 			if err != nil {
 				return err
 			}
 			if !routeListFlags.GenericPrintFlags.OutputFlagSpecified() && len(routeList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No routes found.\n")
-				return nil
+				if !routeListFlags.Watch {
+					return nil
+				}
+			}
+			if routeListFlags.Watch {
+				return routeListFlags.PrintWatch(cmd.Context(), routeList, func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+					return client.WatchRoutes(ctx, resourceVersion, listConfig...)
+				}, cmd.OutOrStdout())
 			}
 			err = routeListFlags.Print(routeList, cmd.OutOrStdout())
 			if err != nil {
@@ -79,5 +90,6 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(routeListCommand.Flags(), true)
 	routeListFlags.AddFlags(routeListCommand)
+	routeListFlags.AddWatchFlag(routeListCommand)
 	return routeListCommand
 }
@@ -20,8 +20,11 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	client_testing "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -137,6 +140,41 @@ func TestRouteListWithTwoTargetsOutput(t *testing.T) {
 	assert.Check(t, util.ContainsAll(output[1], "foo"))
 }
 
+func TestRouteListWatch(t *testing.T) {
+	route := createMockRouteSingleTarget("foo", "foo-01234", 100)
+	route.ResourceVersion = "1"
+	routeList := &servingv1.RouteList{Items: []servingv1.Route{*route}}
+	routeList.ResourceVersion = "1"
+
+	updated := createMockRouteTwoTarget("foo", "foo-01234", "foo-98765", 20, 80)
+	updated.ResourceVersion = "2"
+	updated.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: "True"}}
+	watcher := watch.NewFakeWithChanSize(2, false)
+	watcher.Modify(updated)
+	watcher.Error(&apierrors.NewForbidden(servingv1.Resource("routes"), "", nil).ErrStatus)
+
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, routeList, nil
+		})
+	fakeServing.AddWatchReactor("routes",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			watchAction := a.(client_testing.WatchAction)
+			assert.Equal(t, watchAction.GetWatchRestrictions().ResourceVersion, "1")
+			return true, watcher, nil
+		})
+	cmd.SetArgs([]string{"route", "list", "foo", "--watch"})
+	err := cmd.Execute()
+	assert.Assert(t, apierrors.IsForbidden(err))
+
+	output := strings.Split(buf.String(), "\n")
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "URL", "READY"))
+	assert.Check(t, util.ContainsAll(output[1], "foo"))
+	assert.Check(t, util.ContainsAll(output[2], "foo", "True"))
+}
+
 func createMockRouteMeta(name string) *servingv1.Route {
 	route := &servingv1.Route{}
 	route.Kind = "Route"
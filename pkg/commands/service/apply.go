@@ -15,8 +15,10 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -41,11 +43,23 @@ kn service apply s0 --image knativesamples/helloworld --env foo=bar
 
 # Read the service declaration from a file
 kn service apply s0 --filename my-svc.yml
+
+# Apply the service with a Kubernetes server-side apply, using 'ci' as field manager
+kn service apply s0 --filename my-svc.yml --server-side --field-manager ci
+
+# Take over the ownership of fields which are managed by other field managers
+kn service apply s0 --filename my-svc.yml --server-side --field-manager ci --force-conflicts
+
+# Validate the service declaration with the API server and print the result without applying it
+kn service apply s0 --filename my-svc.yml --dry-run=server -o yaml
 `
 
 func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 	var applyFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
+	var serverSideFlags serverSideApplyFlags
+	var dryRunFlags commands.DryRunFlags
+	var diagnoseFlags diagnoseFlags
 
 	serviceApplyCommand := &cobra.Command{
 		Use:     "apply NAME",
@@ -55,6 +69,16 @@ func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 			if len(args) != 1 && applyFlags.Filename == "" {
 				return errors.New("'service apply' requires the service name given as single argument")
 			}
+			if err := serverSideFlags.validate(cmd); err != nil {
+				return err
+			}
+			if err := dryRunFlags.Validate(cmd); err != nil {
+				return err
+			}
+			if serverSideFlags.ServerSide && dryRunFlags.DryRun == string(clientservingv1.DryRunClient) {
+				return errors.New("'--dry-run=client' can't be used together with '--server-side', use '--dry-run=server' instead")
+			}
+			cmd.SetContext(dryRunFlags.WithContext(cmd.Context()))
 			name := ""
 			if len(args) == 1 {
 				name = args[0]
@@ -81,30 +105,104 @@ func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			waitDoing, waitVerb, err := examineServiceForApply(cmd, client, service.Name)
+			waitDoing, waitVerb, err := examineServiceForApply(cmd, client, service.Name, !serverSideFlags.ServerSide)
 			if err != nil {
 				return err
 			}
 
-			hasChanged, err := client.ApplyService(cmd.Context(), service)
+			var hasChanged bool
+			if serverSideFlags.ServerSide {
+				// the summary of changed fields would corrupt a manifest printed in the requested output format
+				summaryOut := cmd.OutOrStdout()
+				if dryRunFlags.OutputFlagSpecified() {
+					summaryOut = io.Discard
+				}
+				hasChanged, err = applyServiceServerSide(cmd.Context(), summaryOut, client, service, serverSideFlags)
+			} else {
+				hasChanged, err = client.ApplyService(cmd.Context(), service)
+			}
 			if err != nil {
 				return err
 			}
+			if dryRunFlags.Enabled() {
+				return printDryRunResult(cmd.OutOrStdout(), dryRunFlags, service, waitVerb, namespace)
+			}
 			if !hasChanged {
 				fmt.Fprintf(cmd.OutOrStdout(), "No changes to apply to service '%s'.\n", service.Name)
 
 				return showUrl(cmd.Context(), client, service.Name, "unchanged", "", cmd.OutOrStdout())
 			}
-			return waitIfRequested(cmd.Context(), client, waitFlags, service.Name, waitDoing, waitVerb, "", cmd.OutOrStdout())
+			return waitIfRequested(cmd.Context(), client, waitFlags, service.Name, waitDoing, waitVerb, "", cmd.OutOrStdout(),
+				diagnoseFlags.diagnoser(p, client, cmd.OutOrStdout()))
 		},
 	}
 	commands.AddNamespaceFlags(serviceApplyCommand.Flags(), false)
 	applyFlags.AddCreateFlags(serviceApplyCommand)
 	waitFlags.AddConditionWaitFlags(serviceApplyCommand, commands.WaitDefaultTimeout, "apply", "service", "ready")
+	diagnoseFlags.add(serviceApplyCommand)
+	serverSideFlags.Add(serviceApplyCommand)
+	dryRunFlags.Add(serviceApplyCommand, "service declaration")
+	dryRunFlags.AddOutputFlags(serviceApplyCommand)
 	return serviceApplyCommand
 }
 
-func examineServiceForApply(cmd *cobra.Command, client clientservingv1.KnServingClient, serviceName string) (string, string, error) {
+// serverSideApplyFlags are the flags for using a Kubernetes server-side apply
+type serverSideApplyFlags struct {
+	ServerSide     bool
+	FieldManager   string
+	ForceConflicts bool
+}
+
+// Add the server-side apply flags to the given command
+func (f *serverSideApplyFlags) Add(command *cobra.Command) {
+	command.Flags().BoolVar(&f.ServerSide, "server-side", false,
+		"Use a Kubernetes server-side apply instead of a client-side three-way merge. "+
+			"The API server tracks which field manager owns which field and reports conflicts.")
+	command.Flags().StringVar(&f.FieldManager, "field-manager", clientservingv1.DefaultFieldManager,
+		"Name of the field manager used to track the ownership of fields when using --server-side.")
+	command.Flags().BoolVar(&f.ForceConflicts, "force-conflicts", false,
+		"Take over the ownership of fields managed by other field managers when using --server-side.")
+}
+
+func (f *serverSideApplyFlags) validate(cmd *cobra.Command) error {
+	if f.ServerSide {
+		if f.FieldManager == "" {
+			return errors.New("'--field-manager' must not be empty")
+		}
+		return nil
+	}
+	for _, flag := range []string{"field-manager", "force-conflicts"} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("'--%s' can only be used together with '--server-side'", flag)
+		}
+	}
+	return nil
+}
+
+// applyServiceServerSide applies the service server-side and prints a summary of the changed fields to out
+func applyServiceServerSide(ctx context.Context, out io.Writer, client clientservingv1.KnServingClient, service *servingv1.Service, flags serverSideApplyFlags) (bool, error) {
+	result, err := client.ApplyServiceServerSide(ctx, service, clientservingv1.ServerSideApplyOptions{
+		FieldManager:   flags.FieldManager,
+		ForceConflicts: flags.ForceConflicts,
+	})
+	if err != nil {
+		var conflictErr *clientservingv1.FieldManagerConflictError
+		if errors.As(err, &conflictErr) {
+			return false, fmt.Errorf("%w\nUse '--force-conflicts' to take over the ownership of these fields", err)
+		}
+		return false, err
+	}
+	if len(result.ChangedFields) > 0 {
+		fmt.Fprintf(out, "Changed fields of service '%s':\n", service.Name)
+		for _, field := range result.ChangedFields {
+			fmt.Fprintf(out, "  %s\n", field)
+		}
+		fmt.Fprintln(out, "")
+	}
+	return result.Changed, nil
+}
+
+func examineServiceForApply(cmd *cobra.Command, client clientservingv1.KnServingClient, serviceName string, warnIfNotApplied bool) (string, string, error) {
 	currentService, err := client.GetService(cmd.Context(), serviceName)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
@@ -114,7 +212,7 @@ func examineServiceForApply(cmd *cobra.Command, client clientservingv1.KnServing
 	}
 
 	annotationMap := currentService.Annotations
-	if annotationMap != nil {
+	if annotationMap != nil && warnIfNotApplied {
 		if _, ok := annotationMap[corev1.LastAppliedConfigAnnotation]; !ok {
 			fmt.Fprintf(cmd.OutOrStdout(), "Warning: 'kn service apply' should be used only for services created by 'kn service apply'\n")
 		}
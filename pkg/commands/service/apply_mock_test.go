@@ -25,6 +25,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
 
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util/mock"
@@ -185,3 +186,87 @@ func createServiceWithImage(name string, image string) *servingv1.Service {
 		},
 	}
 }
+
+func TestServiceApplyServerSideMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", createServiceWithImage("foo", "gcr.io/foo/bar:v1"), nil)
+	r.ApplyServiceServerSide(mock.Any(), knclient.ServerSideApplyOptions{FieldManager: "ci", ForceConflicts: true},
+		&knclient.ServerSideApplyResult{Changed: true, ChangedFields: []string{".spec.template.spec.containers[0].image"}}, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--server-side", "--field-manager", "ci", "--force-conflicts")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Changed fields", ".spec.template.spec.containers[0].image", "applied", "http://foo.example.com"))
+	assert.Assert(t, util.ContainsNone(output, "Warning"))
+
+	r.Validate()
+}
+
+func TestServiceApplyServerSideConflictMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", createServiceWithImage("foo", "gcr.io/foo/bar:v1"), nil)
+	r.ApplyServiceServerSide(mock.Any(), knclient.ServerSideApplyOptions{FieldManager: "kn"}, (*knclient.ServerSideApplyResult)(nil),
+		&knclient.FieldManagerConflictError{Name: "foo", Conflicts: map[string][]string{"kubectl": {".spec.template.spec.containers[0].image"}}})
+
+	_, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--server-side")
+	assert.ErrorContains(t, err, "kubectl")
+	assert.ErrorContains(t, err, "--force-conflicts")
+
+	r.Validate()
+}
+
+func TestServiceApplyServerSideFlagValidation(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--force-conflicts")
+	assert.ErrorContains(t, err, "--server-side")
+
+	_, err = executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--server-side", "--field-manager", "")
+	assert.ErrorContains(t, err, "field-manager")
+}
+
+func TestServiceApplyDryRunMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", createServiceWithImage("foo", "gcr.io/foo/bar:v1"), nil)
+	r.ApplyService(mock.Any(), true, nil)
+
+	output, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--dry-run=server", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"kind": "Service"`, `"image": "gcr.io/foo/bar:v2"`))
+	assert.Assert(t, util.ContainsNone(output, "Applying"))
+
+	r.Validate()
+}
+
+func TestServiceApplyServerSideDryRunOutputMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", createServiceWithImage("foo", "gcr.io/foo/bar:v1"), nil)
+	r.ApplyServiceServerSide(mock.Any(), knclient.ServerSideApplyOptions{FieldManager: "kn"},
+		&knclient.ServerSideApplyResult{Changed: true, ChangedFields: []string{".spec.template.spec.containers[0].image"}}, nil)
+
+	output, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--server-side", "--dry-run=server", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsNone(output, "Changed fields"))
+	service := &servingv1.Service{}
+	assert.NilError(t, yaml.UnmarshalStrict([]byte(output), service))
+	assert.Equal(t, service.Name, "foo")
+	assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+
+	r.Validate()
+}
+
+func TestServiceApplyDryRunServerSideValidation(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--server-side", "--dry-run=client")
+	assert.ErrorContains(t, err, "--dry-run=server")
+}
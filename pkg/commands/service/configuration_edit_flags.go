@@ -269,8 +269,8 @@ func (p *ConfigurationEditFlags) Apply(
 	}
 
 	// If some change happened that can cause a revision, set the update timestamp
-	// But not for "apply", this would destroy idempotency
-	if p.AnyMutation(cmd) && cmd.Name() != "apply" {
+	// But not for "apply", this would destroy idempotency, and neither for "diff" which only previews a change
+	if p.AnyMutation(cmd) && cmd.Name() != "apply" && cmd.Name() != "diff" {
 		servinglib.UpdateTimestampAnnotation(template)
 	}
 
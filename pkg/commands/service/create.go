@@ -82,6 +82,15 @@ var create_example = `
   kn service create gitopstest --image knativesamples/helloworld --target=/user/knfiles/test.yaml
   kn service create gitopstest --image knativesamples/helloworld --target=/user/knfiles/test.json
 
+  # Add the service as a document to a multi-document YAML file, keeping the other documents
+  kn service create gitopstest --image knativesamples/helloworld --target=/user/knfiles/app.yaml
+
+  # Create the service in a directory with a kustomization.yaml and add it to its resources
+  kn service create gitopstest --image knativesamples/helloworld --target=/user/knfiles/overlays/prod
+
+  # Validate a service with the API server and print it without creating it
+  kn service create s1 --image knativesamples/helloworld --dry-run=server -o yaml
+
   # Create a service with profile
   kn service create profiletest --image knativesamples/helloworld --profile istio
 
@@ -98,6 +107,8 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
 	var trafficFlags flags.Traffic
+	var dryRunFlags commands.DryRunFlags
+	var diagnoseFlags diagnoseFlags
 
 	serviceCreateCommand := &cobra.Command{
 		Use:     "create NAME --image IMAGE",
@@ -114,6 +125,10 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			if editFlags.PodSpecFlags.Image == "" && editFlags.Filename == "" {
 				return errors.New("'service create' requires the image name to run provided with the --image option")
 			}
+			if err := dryRunFlags.Validate(cmd); err != nil {
+				return err
+			}
+			cmd.SetContext(dryRunFlags.WithContext(cmd.Context()))
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -149,15 +164,16 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			out := cmd.OutOrStdout()
+			diagnose := diagnoseFlags.diagnoser(p, client, out)
 			if serviceExists {
 				if !editFlags.ForceCreate {
 					return fmt.Errorf(
 						"cannot create service '%s' in namespace '%s' "+
 							"because the service already exists and no --force option was given", service.Name, namespace)
 				}
-				err = replaceService(cmd.Context(), client, service, waitFlags, out, targetFlag)
+				err = replaceService(cmd.Context(), client, service, waitFlags, dryRunFlags, out, targetFlag, diagnose)
 			} else {
-				err = createService(cmd.Context(), client, service, waitFlags, out, targetFlag)
+				err = createService(cmd.Context(), client, service, waitFlags, dryRunFlags, out, targetFlag, diagnose)
 			}
 			if err != nil {
 				return err
@@ -170,31 +186,40 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	editFlags.AddCreateFlags(serviceCreateCommand)
 	trafficFlags.AddTagFlag(serviceCreateCommand)
 	waitFlags.AddConditionWaitFlags(serviceCreateCommand, commands.WaitDefaultTimeout, "create", "service", "ready")
+	diagnoseFlags.add(serviceCreateCommand)
+	dryRunFlags.Add(serviceCreateCommand, "service creation")
+	dryRunFlags.AddOutputFlags(serviceCreateCommand)
 	return serviceCreateCommand
 }
 
-func createService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, out io.Writer, targetFlag string) error {
+func createService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, dryRunFlags commands.DryRunFlags, out io.Writer, targetFlag string, diagnose diagnoseFunc) error {
 	err := client.CreateService(ctx, service)
 	if err != nil {
 		return err
 	}
+	if dryRunFlags.Enabled() {
+		return printDryRunResult(out, dryRunFlags, service, "created", client.Namespace())
+	}
 
-	return waitIfRequested(ctx, client, waitFlags, service.Name, "Creating", "created", targetFlag, out)
+	return waitIfRequested(ctx, client, waitFlags, service.Name, "Creating", "created", targetFlag, out, diagnose)
 }
 
-func replaceService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, out io.Writer, targetFlag string) error {
+func replaceService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, dryRunFlags commands.DryRunFlags, out io.Writer, targetFlag string, diagnose diagnoseFunc) error {
 	changed, err := prepareAndUpdateService(ctx, client, service)
 	if err != nil {
 		return err
 	}
+	if dryRunFlags.Enabled() {
+		return printDryRunResult(out, dryRunFlags, service, "replaced", client.Namespace())
+	}
 	if !changed {
 		fmt.Fprintf(out, "Service '%s' replaced in namespace '%s' (unchanged).\n", service.Name, client.Namespace())
 		return nil
 	}
-	return waitIfRequested(ctx, client, waitFlags, service.Name, "Replacing", "replaced", targetFlag, out)
+	return waitIfRequested(ctx, client, waitFlags, service.Name, "Replacing", "replaced", targetFlag, out, diagnose)
 }
 
-func waitIfRequested(ctx context.Context, client clientservingv1.KnServingClient, waitFlags commands.WaitFlags, serviceName string, verbDoing string, verbDone string, targetFlag string, out io.Writer) error {
+func waitIfRequested(ctx context.Context, client clientservingv1.KnServingClient, waitFlags commands.WaitFlags, serviceName string, verbDoing string, verbDone string, targetFlag string, out io.Writer, diagnose diagnoseFunc) error {
 	if !waitFlags.Wait || targetFlag != "" {
 		fmt.Fprintf(out, "Service '%s' %s in namespace '%s'.\n", serviceName, verbDone, client.Namespace())
 		return nil
@@ -204,7 +229,16 @@ func waitIfRequested(ctx context.Context, client clientservingv1.KnServingClient
 		Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
 		ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
 	}
-	return waitForServiceToGetReady(ctx, client, serviceName, wconfig, verbDone, out)
+	return waitForServiceToGetReady(ctx, client, serviceName, wconfig, verbDone, out, diagnose)
+}
+
+// printDryRunResult prints the service as it would have been stored, or a summary if no output format is given
+func printDryRunResult(out io.Writer, dryRunFlags commands.DryRunFlags, service *servingv1.Service, verbDone string, namespace string) error {
+	service.TypeMeta = metav1.TypeMeta{
+		APIVersion: servingv1.SchemeGroupVersion.String(),
+		Kind:       "Service",
+	}
+	return dryRunFlags.PrintResult(out, service, fmt.Sprintf("Service '%s' %s in namespace '%s'", service.Name, verbDone, namespace))
 }
 
 func prepareAndUpdateService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service) (bool, error) {
@@ -237,10 +271,13 @@ func prepareAndUpdateService(ctx context.Context, client clientservingv1.KnServi
 
 }
 
-func waitForServiceToGetReady(ctx context.Context, client clientservingv1.KnServingClient, name string, wconfig clientservingv1.WaitConfig, verbDone string, out io.Writer) error {
+func waitForServiceToGetReady(ctx context.Context, client clientservingv1.KnServingClient, name string, wconfig clientservingv1.WaitConfig, verbDone string, out io.Writer, diagnose diagnoseFunc) error {
 	fmt.Fprintln(out, "")
 	err := waitForService(ctx, client, name, out, wconfig)
 	if err != nil {
+		if diagnose != nil {
+			diagnose(ctx, name)
+		}
 		return err
 	}
 	fmt.Fprintln(out, "")
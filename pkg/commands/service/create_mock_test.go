@@ -15,19 +15,26 @@
 package service
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 	"time"
 
 	"knative.dev/serving/pkg/apis/autoscaling"
 
+	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"knative.dev/pkg/apis"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/commands"
+	knflags "knative.dev/client/pkg/flags"
 	servinglib "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util/mock"
@@ -62,6 +69,65 @@ func TestServiceCreateImageMock(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceCreateDiagnoseMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	pod := newServicePod("foo-00001-deployment-1", "foo", "foo-00001")
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image \"gcr.io/foo/bar:nope\""},
+	}
+	kubeClient := fake.NewSimpleClientset(pod)
+	service := getService("foo")
+	service.Status.LatestCreatedRevisionName = "foo-00001"
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: "default"}}
+	waitErr := fmt.Errorf("RevisionMissing: Configuration \"foo\" does not have any ready Revision")
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), waitErr, time.Second)
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00001", revision, nil)
+
+	output, err := executeServiceDiagnoseCommand(client, kubeClient, "create", "foo", "--image", "gcr.io/foo/bar:nope")
+	assert.ErrorContains(t, err, "RevisionMissing")
+	assert.Assert(t, util.ContainsAll(output, "Diagnosis of revision 'foo-00001' of service 'foo'",
+		"ImagePullFailed: Pod/foo-00001-deployment-1 (container user-container)", "Back-off pulling image \"gcr.io/foo/bar:nope\""))
+
+	// no diagnosis when opted out
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), waitErr, time.Second)
+
+	output, err = executeServiceDiagnoseCommand(client, kubeClient, "create", "foo", "--image", "gcr.io/foo/bar:nope", "--no-diagnose")
+	assert.ErrorContains(t, err, "RevisionMissing")
+	assert.Assert(t, util.ContainsNone(output, "Diagnosis"))
+
+	r.Validate()
+}
+
+// executeServiceDiagnoseCommand executes a service command which diagnoses failures with the given kube client
+func executeServiceDiagnoseCommand(client knclient.KnServingClient, kubeClient kubernetes.Interface, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return knflags.ReconcileBoolFlags(cmd.Flags())
+	}
+	err := cmd.Execute()
+	return output.String(), err
+}
+
 func TestServiceCreateEnvMock(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 
@@ -658,3 +724,47 @@ func setUrl(service *servingv1.Service, urlName string) {
 	url, _ := apis.ParseURL(urlName)
 	service.Status.URL = url
 }
+
+func TestServiceCreateDryRunMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+
+	// No waiting for a service which has not been created
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run=server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' created", "(server dry run)"))
+	assert.Assert(t, util.ContainsNone(output, "Creating"))
+
+	r.Validate()
+}
+
+func TestServiceCreateDryRunOutputMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run=client", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "kind: Service", "name: foo", "image: gcr.io/foo/bar:baz"))
+	assert.Assert(t, util.ContainsNone(output, "dry run"))
+
+	r.Validate()
+}
+
+func TestServiceCreateDryRunFlagValidation(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run=maybe")
+	assert.ErrorContains(t, err, "'none', 'client' or 'server'")
+
+	_, err = executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "-o", "yaml")
+	assert.ErrorContains(t, err, "--dry-run")
+
+	_, err = executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run=server", "--target", t.TempDir())
+	assert.ErrorContains(t, err, "--target")
+}
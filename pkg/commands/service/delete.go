@@ -30,6 +30,7 @@ import (
 // NewServiceDeleteCommand represent 'service delete' command
 func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 	var waitFlags commands.WaitFlags
+	var dryRunFlags commands.DryRunFlags
 
 	serviceDeleteCommand := &cobra.Command{
 		Use:   "delete NAME [NAME ...]",
@@ -47,7 +48,10 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
   # Delete the services in offline mode instead of kubernetes cluster (Beta)
   kn service delete test -n test-ns --target=/user/knfiles
   kn service delete test --target=/user/knfiles/test.yaml
-  kn service delete test --target=/user/knfiles/test.json`,
+  kn service delete test --target=/user/knfiles/test.json
+
+  # Check whether a service 'svc1' could be deleted, without deleting it
+  kn service delete svc1 --dry-run=server`,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			all, err := cmd.Flags().GetBool("all")
@@ -63,6 +67,10 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 			if argsLen > 0 && all {
 				return errors.New("'service delete' with --all flag requires no arguments")
 			}
+			if err := dryRunFlags.Validate(cmd); err != nil {
+				return err
+			}
+			cmd.SetContext(dryRunFlags.WithContext(cmd.Context()))
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -93,6 +101,8 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 				err = client.DeleteService(cmd.Context(), name, timeout)
 				if err != nil {
 					errs = append(errs, err.Error())
+				} else if dryRunFlags.Enabled() {
+					fmt.Fprintf(cmd.OutOrStdout(), "Service '%s' deleted in namespace '%s' (%s dry run).\n", name, namespace, dryRunFlags.DryRun)
 				} else {
 					fmt.Fprintf(cmd.OutOrStdout(), "Service '%s' successfully deleted in namespace '%s'.\n", name, namespace)
 				}
@@ -109,6 +119,7 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(serviceDeleteCommand.Flags(), false)
 	commands.AddGitOpsFlags(serviceDeleteCommand.Flags())
 	waitFlags.AddConditionWaitFlags(serviceDeleteCommand, commands.WaitDefaultTimeout, "delete", "service", "deleted")
+	dryRunFlags.Add(serviceDeleteCommand, "service deletion")
 	return serviceDeleteCommand
 }
 
@@ -162,3 +162,17 @@ func TestServiceDeleteCheckErrorForNotFoundServicesMock(t *testing.T) {
 
 	r.Validate()
 }
+
+func TestServiceDeleteDryRunMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.DeleteService("foo", mock.Any(), nil)
+
+	output, err := executeServiceCommand(client, "delete", "foo", "--dry-run=server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' deleted", "(server dry run)"))
+	assert.Assert(t, util.ContainsNone(output, "successfully"))
+
+	r.Validate()
+}
@@ -28,9 +28,11 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 
 	"knative.dev/client/pkg/commands/revision"
+	"knative.dev/client/pkg/output/term"
 	"knative.dev/client/pkg/plugin"
 	"knative.dev/client/pkg/printers"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -83,6 +85,12 @@ var describe_example = `
   # Print only service URL
   kn service describe svc -o url
 
+  # Describe service 'svc' with a bar chart of its traffic split
+  kn service describe svc --traffic-graph
+
+  # Describe service 'svc' including the events of its revisions and pods
+  kn service describe svc --events
+
   # Describe the services in offline mode instead of kubernetes cluster (Beta)
   kn service describe test -n test-ns --target=/user/knfiles
   kn service describe test --target=/user/knfiles/test.yaml
@@ -94,6 +102,8 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 	// For machine readable output
 	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
 
+	var showEvents, trafficGraph bool
+
 	command := &cobra.Command{
 		Use:               "describe NAME",
 		Short:             "Show details of a service",
@@ -121,6 +131,9 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			if showEvents && cmd.Flag("target").Value.String() != "" {
+				return errors.New("--events can't be used together with --target, as events are only available in a cluster")
+			}
 			client, err := newServingClient(p, namespace, cmd.Flag("target").Value.String())
 			if err != nil {
 				return err
@@ -155,20 +168,40 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return describe(cmd.OutOrStdout(), service, revisionDescs, printDetails)
+			out := cmd.OutOrStdout()
+			if err := describe(out, service, revisionDescs, printDetails, trafficGraph); err != nil {
+				return err
+			}
+			if !showEvents {
+				return nil
+			}
+			kubeClient, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+			events, err := clientservingv1.NewEventCollector(client, kubeClient, serviceName).List(cmd.Context())
+			if err != nil {
+				return err
+			}
+			dw := printers.NewPrefixWriter(out)
+			dw.WriteLine()
+			writeEvents(dw, events, term.IsFancy(out))
+			return dw.Flush()
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.BoolVar(&trafficGraph, "traffic-graph", false, "Show the traffic split as a bar chart, with the readiness of the revisions.")
+	flags.BoolVar(&showEvents, "events", false, "Show the events of the service and of the revisions, deployments and pods it owns.")
 	machineReadablePrintFlags.AddFlags(command)
 	command.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	return command
 }
 
 // Main action describing the service
-func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc, printDetails bool) error {
+func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc, printDetails bool, trafficGraph bool) error {
 	dw := printers.NewPrefixWriter(w)
 
 	// Service info
@@ -185,6 +218,14 @@ func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc
 		return err
 	}
 
+	if trafficGraph {
+		writeTrafficGraph(dw, service, revisions, term.IsFancy(w))
+		dw.WriteLine()
+		if err := dw.Flush(); err != nil {
+			return err
+		}
+	}
+
 	// Condition info
 	commands.WriteConditions(dw, service.Status.Conditions, printDetails)
 	if err := dw.Flush(); err != nil {
@@ -246,6 +287,17 @@ func writeRevisions(dw printers.PrefixWriter, revisions []*revisionDesc, printDe
 	}
 }
 
+// Write the traffic split of the service as a bar chart
+func writeTrafficGraph(dw printers.PrefixWriter, service *servingv1.Service, revisions []*revisionDesc, fancy bool) {
+	trafficSection := dw.WriteAttribute("Traffic", "")
+	dw.Flush()
+	revs := make([]servingv1.Revision, 0, len(revisions))
+	for _, desc := range revisions {
+		revs = append(revs, *desc.revision)
+	}
+	traffic.ServiceTraffic(service.Status.Traffic).WriteGraph(trafficSection, traffic.RevisionReadiness(revs), fancy)
+}
+
 // ======================================================================================
 // Helper functions
 
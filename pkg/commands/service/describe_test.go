@@ -27,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/serving/pkg/apis/autoscaling"
@@ -36,6 +37,7 @@ import (
 	client_serving "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
 	"knative.dev/pkg/ptr"
 )
 
@@ -145,6 +147,28 @@ func TestServiceDescribeLatest(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceDescribeTrafficGraph(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	expectedService := createTestService("foo", []string{"rev1", "rev2"}, goodConditions())
+	expectedService.Status.Traffic[1].LatestRevision = ptr.Bool(true)
+	expectedService.Status.Traffic[0].Tag = "old"
+	r.GetService("foo", &expectedService, nil)
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	r.GetRevision("rev1", &rev1, nil)
+	rev2 := createTestRevision("rev2", 2, goodConditions())
+	r.GetRevision("rev2", &rev2, nil)
+
+	output, err := executeServiceCommand(client, "describe", "foo", "--traffic-graph")
+	assert.NilError(t, err)
+	validateServiceOutput(t, "foo", output)
+	assert.Assert(t, cmp.Regexp(`(?m)^Traffic:\s*\n\s+##########\.{10}\s+50%\s+rev1 #old\s+Ready\s+https://rev1\n`, output))
+	assert.Assert(t, cmp.Regexp(`(?m)^\s+##########\.{10}\s+50%\s+@latest \(rev2\)\s+Ready$`, output))
+
+	r.Validate()
+}
+
 func TestServiceDescribeLatestNotInTraffic(t *testing.T) {
 
 	// New mock client
@@ -510,6 +534,30 @@ func TestServiceDescribeWithWrongArguments(t *testing.T) {
 	assert.ErrorContains(t, err, "requires", "name", "service", "single", "argument")
 }
 
+func TestServiceDescribeEvents(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	expectedService := createTestService("foo", []string{"rev1"}, goodConditions())
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	r.GetService("foo", &expectedService, nil)
+	r.GetRevision("rev1", &rev1, nil)
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{rev1}}, nil)
+	kubeClient := fake.NewSimpleClientset(
+		newServiceEvent("e1", "Revision", "rev1", v1.EventTypeWarning, "InternalError", "failed to reconcile", time.Minute),
+	)
+
+	output, err := executeServiceLogsCommand(client, kubeClient, "describe", "foo", "--events")
+	assert.NilError(t, err)
+	validateServiceOutput(t, "foo", output)
+	assert.Assert(t, util.ContainsAll(output, "Events:", "LAST SEEN", "Revision/rev1", "InternalError", "(!) failed to reconcile"))
+
+	_, err = executeServiceCommand(client, "describe", "foo", "--events", "--target", t.TempDir())
+	assert.ErrorContains(t, err, "--events can't be used together with --target")
+
+	r.Validate()
+}
+
 func TestServiceDescribeMachineReadable(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 
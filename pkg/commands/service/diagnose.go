@@ -0,0 +1,64 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/diagnose"
+	knflags "knative.dev/client/pkg/flags"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// diagnoseFunc prints why a service didn't become ready while waiting for it
+type diagnoseFunc func(ctx context.Context, name string)
+
+// diagnoseFlags control whether a service is diagnosed when waiting for it fails
+type diagnoseFlags struct {
+	enabled bool
+}
+
+func (f *diagnoseFlags) add(command *cobra.Command) {
+	knflags.AddBothBoolFlags(command.Flags(), &f.enabled, "diagnose", "", true,
+		"Diagnose why the service doesn't become ready when waiting for it fails, by inspecting its latest revision and pods.")
+}
+
+// diagnoser returns the function to call when waiting for the service fails, nil if the
+// diagnosis is disabled. A diagnosis which fails itself is reported, but doesn't change
+// the error of the command.
+func (f *diagnoseFlags) diagnoser(p *commands.KnParams, client clientservingv1.KnServingClient, out io.Writer) diagnoseFunc {
+	if !f.enabled {
+		return nil
+	}
+	return func(ctx context.Context, name string) {
+		kubeClient, err := p.NewKubeClient()
+		if err == nil {
+			var diagnosis *diagnose.Diagnosis
+			diagnosis, err = diagnose.NewDiagnoser(client, kubeClient).Diagnose(ctx, name)
+			if err == nil {
+				fmt.Fprintln(out, "")
+				diagnosis.Write(out)
+				fmt.Fprintln(out, "")
+				return
+			}
+		}
+		fmt.Fprintf(out, "\nCannot diagnose service '%s': %v\n\n", name, err)
+	}
+}
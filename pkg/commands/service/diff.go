@@ -0,0 +1,265 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/commands/flags"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+	"knative.dev/client/pkg/util"
+)
+
+var diffExample = `
+  # Show what an update of service 'svc' with a new image and environment variable would change
+  kn service diff svc --image knativesamples/helloworld:v2 --env TARGET=v2
+
+  # Show what a traffic split would change
+  kn service diff svc --traffic svc-00001=50,@latest=50
+
+  # Show what applying a service declaration from a file would change
+  kn service diff -f my-svc.yaml
+
+  # Check in a CI pipeline that the service on the cluster matches its declaration
+  kn service diff -f my-svc.yaml || echo "Service has drifted"`
+
+// metadataIgnoredForDiff are metadata fields which are managed by the API server
+var metadataIgnoredForDiff = []string{
+	"creationTimestamp",
+	"generation",
+	"managedFields",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+// annotationsIgnoredForDiff are annotations which are managed by the server or kn itself
+var annotationsIgnoredForDiff = []string{
+	serving.CreatorAnnotation,
+	serving.UpdaterAnnotation,
+	corev1.LastAppliedConfigAnnotation,
+}
+
+// NewServiceDiffCommand represents 'kn service diff' command
+func NewServiceDiffCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ConfigurationEditFlags
+	var trafficFlags flags.Traffic
+
+	serviceDiffCommand := &cobra.Command{
+		Use:   "diff NAME",
+		Short: "Show the changes an update or apply would perform on a service",
+		Long: `Show the changes an update or apply would perform on a service
+
+The service is computed from the given options or file and sent to the API server as dry-run, so that
+server-side defaulting and admission webhooks are taken into account. Fields managed by the server are
+ignored. Like diff, the command exits with 0 if no differences have been found, with 1 if differences
+have been found and with 2 if an error occurred.`,
+		Example:           diffExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				err = knerrors.WithDefaultExitCode(diffErrorExitCode, err)
+			}()
+			if len(args) != 1 && editFlags.Filename == "" {
+				return errors.New("'service diff' requires the service name given as single argument")
+			}
+			name := ""
+			if len(args) == 1 {
+				name = args[0]
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			var current, desired *servingv1.Service
+			if editFlags.Filename != "" {
+				current, desired, err = dryRunApplyFromFile(cmd, client, editFlags, name, namespace)
+			} else {
+				current, desired, err = dryRunUpdate(cmd, client, editFlags, &trafficFlags, name, namespace)
+			}
+			if err != nil {
+				return err
+			}
+
+			currentYaml, err := serviceYamlForDiff(current)
+			if err != nil {
+				return err
+			}
+			desiredYaml, err := serviceYamlForDiff(desired)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			differs, err := printers.PrintUnifiedDiff(out,
+				fmt.Sprintf("service/%s (current)", desired.Name),
+				fmt.Sprintf("service/%s (desired)", desired.Name),
+				currentYaml, desiredYaml, term.IsFancy(out))
+			if err != nil {
+				return err
+			}
+			if differs {
+				return knerrors.NewExitCodeError(1, "")
+			}
+			fmt.Fprintf(out, "No differences found for service '%s' in namespace '%s'.\n", desired.Name, namespace)
+			return nil
+		},
+	}
+	serviceDiffCommand.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return knerrors.WithDefaultExitCode(diffErrorExitCode, err)
+	})
+	commands.AddNamespaceFlags(serviceDiffCommand.Flags(), false)
+	editFlags.AddUpdateFlags(serviceDiffCommand)
+	serviceDiffCommand.Flags().StringVarP(&editFlags.Filename, "filename", "f", "", "Compare the service with the declaration "+
+		"from the given file, as 'kn service apply' would do. The declaration can be further modified by combining with other options.")
+	serviceDiffCommand.MarkFlagFilename("filename")
+	editFlags.markFlagMakesRevision("filename")
+	trafficFlags.Add(serviceDiffCommand)
+	return serviceDiffCommand
+}
+
+// diffErrorExitCode is the exit code of a failed diff, which tells it apart from
+// the exit code 1 signaling found differences
+const diffErrorExitCode = 2
+
+// dryRunApplyFromFile performs a dry-run of 'service apply' with the service declaration from a file.
+// It returns the current service (nil if not existing) and the service as it would look like after the apply.
+func dryRunApplyFromFile(cmd *cobra.Command, client clientservingv1.KnServingClient, editFlags ConfigurationEditFlags, name string, namespace string) (*servingv1.Service, *servingv1.Service, error) {
+	desired, err := constructServiceFromFile(cmd, editFlags, name, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := getServiceIfExists(cmd.Context(), client, desired.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := clientservingv1.WithDryRun(cmd.Context(), clientservingv1.DryRunServer)
+	if current == nil {
+		err = client.CreateService(ctx, desired)
+	} else {
+		_, err = client.ApplyService(ctx, desired)
+	}
+	return current, desired, err
+}
+
+// dryRunUpdate performs a dry-run of 'service update' (or 'service create' if the service doesn't exist yet).
+// It returns the current service (nil if not existing) and the service as it would look like after the update.
+func dryRunUpdate(cmd *cobra.Command, client clientservingv1.KnServingClient, editFlags ConfigurationEditFlags, trafficFlags *flags.Traffic, name string, namespace string) (*servingv1.Service, *servingv1.Service, error) {
+	current, err := getServiceIfExists(cmd.Context(), client, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := clientservingv1.WithDryRun(cmd.Context(), clientservingv1.DryRunServer)
+	if current == nil {
+		if editFlags.PodSpecFlags.Image == "" {
+			return nil, nil, fmt.Errorf("service '%s' not found in namespace '%s', provide an image with --image to preview its creation", name, namespace)
+		}
+		desired, err := constructService(cmd, editFlags, name, namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, desired, client.CreateService(ctx, desired)
+	}
+
+	desired := current.DeepCopy()
+	var baseRevision *servingv1.Revision
+	if isImagePinned(cmd, editFlags) {
+		baseRevision, err = client.GetBaseRevision(cmd.Context(), desired)
+		var errNoBaseRevision clientservingv1.NoBaseRevisionError
+		if errors.As(err, &errNoBaseRevision) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Warning: No revision found to update image digest")
+		}
+	}
+	err = editFlags.Apply(desired, baseRevision, cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	if trafficFlags.Changed(cmd) {
+		revisions, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(name))
+		if err != nil {
+			return nil, nil, err
+		}
+		desired.Spec.Traffic, err = traffic.Compute(cmd, desired, trafficFlags, revisions.Items, editFlags.AnyMutation(cmd))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	_, err = client.UpdateService(ctx, desired)
+	return current, desired, err
+}
+
+func getServiceIfExists(ctx context.Context, client clientservingv1.KnServingClient, name string) (*servingv1.Service, error) {
+	service, err := client.GetService(ctx, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return service, err
+}
+
+// serviceYamlForDiff serializes the service to YAML without status and server managed fields.
+// An empty string is returned for a nil service.
+func serviceYamlForDiff(service *servingv1.Service) (string, error) {
+	if service == nil {
+		return "", nil
+	}
+	service = service.DeepCopy()
+	service.TypeMeta = metav1.TypeMeta{
+		APIVersion: servingv1.SchemeGroupVersion.String(),
+		Kind:       "Service",
+	}
+	uService, err := util.ToUnstructured(service)
+	if err != nil {
+		return "", err
+	}
+	unstructured.RemoveNestedField(uService.Object, "status")
+	for _, field := range metadataIgnoredForDiff {
+		unstructured.RemoveNestedField(uService.Object, "metadata", field)
+	}
+	annotations := uService.GetAnnotations()
+	for _, annotation := range annotationsIgnoredForDiff {
+		delete(annotations, annotation)
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	uService.SetAnnotations(annotations)
+
+	data, err := yaml.Marshal(uService.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
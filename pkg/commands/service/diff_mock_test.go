@@ -0,0 +1,123 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	knerrors "knative.dev/client/pkg/errors"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceDiffUpdateMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	service := createServiceWithImage("foo", "gcr.io/foo/bar:v1")
+	service.ResourceVersion = "1"
+	service.Generation = 1
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		svc := a.(*servingv1.Service)
+		assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+		// Server managed fields which would be updated by a dry-run
+		svc.Generation = 2
+		svc.ResourceVersion = "2"
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "diff", "foo", "--image", "gcr.io/foo/bar:v2", "--no-lock-to-digest")
+	var exitErr *knerrors.ExitCodeError
+	assert.Assert(t, errors.As(err, &exitErr))
+	assert.Equal(t, exitErr.ExitCode(), 1)
+	assert.Assert(t, util.ContainsAll(output, "--- service/foo (current)", "+++ service/foo (desired)",
+		"-      - image: gcr.io/foo/bar:v1", "+      - image: gcr.io/foo/bar:v2"))
+	assert.Assert(t, util.ContainsNone(output, "generation:", "resourceVersion:"))
+
+	r.Validate()
+}
+
+func TestServiceDiffCreateMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, apierrors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+
+	output, err := executeServiceCommand(client, "diff", "foo", "--image", "gcr.io/foo/bar:v1")
+	assert.Assert(t, err != nil)
+	assert.Assert(t, util.ContainsAll(output, "+apiVersion: serving.knative.dev/v1", "+  name: foo", "gcr.io/foo/bar:v1"))
+
+	r.Validate()
+}
+
+func TestServiceDiffNotFoundWithoutImageMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, apierrors.NewNotFound(servingv1.Resource("service"), "foo"))
+
+	_, err := executeServiceCommand(client, "diff", "foo", "--env", "foo=bar")
+	assert.ErrorContains(t, err, "--image")
+
+	r.Validate()
+}
+
+func TestServiceDiffFromFileNoChangesMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	service := createServiceWithImage("foo", "gcr.io/foo/bar:v1")
+	service.APIVersion = "serving.knative.dev/v1"
+	service.Kind = "Service"
+	data, err := yaml.Marshal(service)
+	assert.NilError(t, err)
+	file := filepath.Join(t.TempDir(), "service.yaml")
+	assert.NilError(t, os.WriteFile(file, data, 0600))
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.ApplyService(mock.Any(), false, nil)
+
+	output, err := executeServiceCommand(client, "diff", "-f", file)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No differences", "foo"))
+
+	r.Validate()
+}
+
+func TestServiceDiffNoNameMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "diff")
+	assert.ErrorContains(t, err, "requires the service name")
+	// errors are told apart from found differences
+	var exitErr *knerrors.ExitCodeError
+	assert.Assert(t, errors.As(err, &exitErr))
+	assert.Equal(t, exitErr.ExitCode(), 2)
+
+	_, err = executeServiceCommand(client, "diff", "foo", "--no-such-flag")
+	assert.ErrorContains(t, err, "unknown flag")
+	assert.Assert(t, errors.As(err, &exitErr))
+	assert.Equal(t, exitErr.ExitCode(), 2)
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+const (
+	eventColorProblem = "\x1b[31m"
+	eventColorReset   = "\x1b[0m"
+)
+
+var eventsExample = `
+  # Show the events of service 'svc' and of its revisions, deployments and pods
+  kn service events svc
+
+  # Show the events and watch for new ones, e.g. while a new revision is rolled out
+  kn service events svc -w`
+
+// NewServiceEventsCommand creates a new command for showing the events of a service
+func NewServiceEventsCommand(p *commands.KnParams) *cobra.Command {
+	var watch bool
+
+	command := &cobra.Command{
+		Use:   "events NAME",
+		Short: "Show the events of a service and the objects it owns",
+		Long: `Show the events of a service and the objects it owns
+
+The events of the service, its configuration, route and revisions, and of the
+deployments and pods of the revisions are shown sorted by time. Repeated events
+are shown once, and events hinting at a problem, like failed image pulls, crashing
+containers or failed probes, are highlighted.`,
+		Example:           eventsExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service events' requires the service name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			if _, err := client.GetService(cmd.Context(), name); err != nil {
+				return err
+			}
+			kubeClient, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+
+			collector := clientservingv1.NewEventCollector(client, kubeClient, name)
+			events, err := collector.List(cmd.Context())
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			color := term.IsFancy(out)
+			dw := printers.NewPrefixWriter(out)
+			if len(events) == 0 && !watch {
+				fmt.Fprintf(out, "No events found for service '%s' in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			writeEventsHeader(dw)
+			for _, event := range events {
+				writeEvent(dw, event, color)
+			}
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+			if !watch {
+				return nil
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return collector.Watch(ctx, func(event clientservingv1.Event) {
+				writeEvent(dw, event, color)
+				dw.Flush()
+			})
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch for new events after showing the existing ones.")
+	return command
+}
+
+// writeEvents writes the events as a section of the description of a service
+func writeEvents(dw printers.PrefixWriter, events []clientservingv1.Event, color bool) {
+	section := dw.WriteAttribute("Events", "")
+	if len(events) == 0 {
+		section.WriteColsLn("<none>")
+		return
+	}
+	writeEventsHeader(section)
+	for _, event := range events {
+		writeEvent(section, event, color)
+	}
+}
+
+func writeEventsHeader(dw printers.PrefixWriter) {
+	dw.WriteColsLn("LAST SEEN", "TYPE", "REASON", "OBJECT", "MESSAGE")
+}
+
+// writeEvent writes a single event. Only the message is colored, as it's the last
+// column, so that the escape sequences don't break the alignment of the columns.
+func writeEvent(dw printers.PrefixWriter, event clientservingv1.Event, color bool) {
+	message := event.Message
+	if event.Count > 1 {
+		message = fmt.Sprintf("%s (x%d)", message, event.Count)
+	}
+	if event.IsProblem() {
+		if color {
+			message = eventColorProblem + message + eventColorReset
+		} else {
+			message = "(!) " + message
+		}
+	}
+	dw.WriteColsLn(commands.Age(event.LastSeen), event.Type, event.Reason, event.Object, message)
+}
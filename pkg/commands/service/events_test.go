@@ -0,0 +1,82 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceEvents(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		newServiceEvent("e1", "Service", "foo", corev1.EventTypeNormal, "Created", "Created Configuration \"foo\"", time.Minute),
+		newServiceEvent("e2", "Revision", "foo-00001", corev1.EventTypeWarning, "InternalError", "failed to pull image", time.Second),
+		newServiceEvent("e3", "Service", "bar", corev1.EventTypeNormal, "Created", "Created Configuration \"bar\"", time.Minute),
+	)
+	revisions := &servingv1.RevisionList{Items: []servingv1.Revision{{ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: "default"}}}}
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getService("foo"), nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("baz", getService("baz"), nil)
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{}, nil)
+	r.GetService("qux", nil, apierrors.NewNotFound(servingv1.Resource("service"), "qux"))
+
+	output, err := executeServiceLogsCommand(client, kubeClient, "events", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "LAST SEEN", "TYPE", "REASON", "OBJECT", "MESSAGE"))
+	assert.Assert(t, util.ContainsAll(output, "Service/foo", "Created Configuration \"foo\"", "Revision/foo-00001", "(!) failed to pull image (x2)"))
+	assert.Assert(t, util.ContainsNone(output, "bar"))
+	// sorted by the time seen last
+	assert.Assert(t, strings.Index(output, "Service/foo") < strings.Index(output, "Revision/foo-00001"))
+
+	output, err = executeServiceLogsCommand(client, kubeClient, "events", "baz")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No events found for service 'baz'"))
+
+	_, err = executeServiceLogsCommand(client, kubeClient, "events", "qux")
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	_, err = executeServiceLogsCommand(client, kubeClient, "events")
+	assert.ErrorContains(t, err, "single argument")
+
+	r.Validate()
+}
+
+func newServiceEvent(name, kind, object, eventType, reason, message string, age time.Duration) *corev1.Event {
+	seen := metav1.NewTime(time.Now().Add(-age))
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{APIVersion: "serving.knative.dev/v1", Kind: kind, Name: object, Namespace: "default"},
+		Type:           eventType,
+		Reason:         reason,
+		Message:        message,
+		Count:          2,
+		FirstTimestamp: seen,
+		LastTimestamp:  seen,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"knative.dev/client/pkg/commands"
+	knterm "knative.dev/client/pkg/output/term"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var execExample = `
+  # List the files in the working directory of a pod of service 'svc'
+  kn service exec svc -- ls -l
+
+  # Open an interactive shell in a pod of revision 'svc-00002'
+  kn service exec svc --revision svc-00002 -it -- sh
+
+  # Run a command in the queue-proxy container, starting a pod if needed
+  kn service exec svc -c queue-proxy --scale-up -- env`
+
+// NewServiceExecCommand creates a new command for running a command in a pod of a service
+func NewServiceExecCommand(p *commands.KnParams) *cobra.Command {
+	var podFlags podFlags
+	var container string
+	var stdin, tty bool
+
+	command := &cobra.Command{
+		Use:   "exec NAME [--revision REVISION] [-c CONTAINER] -- COMMAND [ARG...]",
+		Short: "Run a command in a pod of a service",
+		Long: `Run a command in a pod of a service
+
+The command runs in a ready pod of the latest ready revision, or of the given
+revision. With --scale-up a revision without a ready pod is scaled up while the
+command runs.`,
+		Example:           execExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.ArgsLenAtDash() != 1 || len(args) < 2 {
+				return errors.New("'service exec' requires the service name and the command separated by '--' as arguments")
+			}
+			name, command := args[0], args[1:]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			connector, err := p.NewPodConnector()
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			pod, restore, err := podFlags.selectPod(ctx, cmd, p, namespace, name)
+			if err != nil {
+				return err
+			}
+			defer restore()
+
+			opts := clientservingv1.ExecOptions{
+				Container: container,
+				Command:   command,
+				Stdout:    cmd.OutOrStdout(),
+				Stderr:    cmd.ErrOrStderr(),
+			}
+			if opts.Container == "" {
+				opts.Container = clientservingv1.UserContainer(pod)
+			}
+			if stdin {
+				opts.Stdin = cmd.InOrStdin()
+			}
+			if tty {
+				if !stdin || !knterm.IsReaderTerminal(opts.Stdin) {
+					fmt.Fprintln(cmd.ErrOrStderr(), "Warning: unable to use a TTY, input is not a terminal")
+				} else {
+					fd := int(opts.Stdin.(*os.File).Fd())
+					state, err := term.MakeRaw(fd)
+					if err != nil {
+						return err
+					}
+					defer term.Restore(fd, state) //nolint:errcheck
+					opts.TTY = true
+				}
+			}
+			return connector.Exec(ctx, pod, opts)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	podFlags.add(command)
+	command.Flags().StringVarP(&container, "container", "c", "",
+		"Container to run the command in. Defaults to the first container of the service.")
+	command.Flags().BoolVarP(&stdin, "stdin", "i", false, "Pass stdin to the command.")
+	command.Flags().BoolVarP(&tty, "tty", "t", false, "Allocate a TTY for the command, requires --stdin.")
+	return command
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceExec(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		newReadyServicePod("foo-00001-deployment-1", "foo", "foo-00001"),
+		newReadyServicePod("foo-00002-deployment-1", "foo", "foo-00002"),
+	)
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getPodService("foo", "foo-00002"), nil)
+	r.GetService("foo", getPodService("foo", "foo-00002"), nil)
+	r.GetRevision("foo-00001", getPodRevision("foo", "foo-00001", ""), nil)
+	r.GetService("foo", getPodService("foo", "foo-00002"), nil)
+
+	connector := &fakePodConnector{}
+	_, err := executeServicePodCommand(client, kubeClient, connector, "exec", "foo", "--", "ls", "-l")
+	assert.NilError(t, err)
+	assert.Equal(t, connector.pod, "foo-00002-deployment-1")
+	assert.Equal(t, connector.exec.Container, "user-container")
+	assert.DeepEqual(t, connector.exec.Command, []string{"ls", "-l"})
+	assert.Assert(t, connector.exec.Stdin == nil)
+	assert.Assert(t, !connector.exec.TTY)
+
+	_, err = executeServicePodCommand(client, kubeClient, connector, "exec", "foo", "--revision", "foo-00001", "-c", "queue-proxy", "--", "env")
+	assert.NilError(t, err)
+	assert.Equal(t, connector.pod, "foo-00001-deployment-1")
+	assert.Equal(t, connector.exec.Container, "queue-proxy")
+
+	// stdin of tests isn't a terminal, so no TTY is allocated
+	output, err := executeServicePodCommand(client, kubeClient, connector, "exec", "foo", "-it", "--", "sh")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "unable to use a TTY"))
+	assert.Assert(t, connector.exec.Stdin != nil)
+	assert.Assert(t, !connector.exec.TTY)
+
+	r.Validate()
+}
+
+func TestServiceExecErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	kubeClient := fake.NewSimpleClientset()
+	connector := &fakePodConnector{}
+
+	for _, args := range [][]string{
+		{"exec", "foo"},
+		{"exec", "foo", "ls"},
+		{"exec", "foo", "--"},
+		{"exec", "--", "ls"},
+		{"exec", "foo", "bar", "--", "ls"},
+	} {
+		_, err := executeServicePodCommand(client, kubeClient, connector, args...)
+		assert.ErrorContains(t, err, "requires the service name and the command separated by '--'")
+	}
+}
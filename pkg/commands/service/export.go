@@ -48,12 +48,7 @@ var IgnoredServiceAnnotations = []string{
 
 // IgnoredRevisionAnnotations defines the annotation keys which should be
 // removed from revision annotations before export
-var IgnoredRevisionAnnotations = []string{
-	"serving.knative.dev/lastPinned",
-	"serving.knative.dev/creator",
-	"serving.knative.dev/routingStateModified",
-	clientserving.UpdateTimestampAnnotationKey,
-}
+var IgnoredRevisionAnnotations = clientserving.IgnoredRevisionAnnotations
 
 // IgnoredServiceLabels defines the label keys which should be removed
 // from service labels before export
@@ -64,10 +59,7 @@ var IgnoredServiceLabels = []string{
 
 // IgnoredRevisionLabels defines the label keys which should be removed
 // from revision labels before export
-var IgnoredRevisionLabels = []string{
-	"serving.knative.dev/configurationUID",
-	"serving.knative.dev/serviceUID",
-}
+var IgnoredRevisionLabels = clientserving.IgnoredRevisionLabels
 
 const (
 	ModeReplay = "replay"
@@ -124,7 +124,7 @@ func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingCli
 		}
 	}
 
-	err = waitIfRequested(ctx, client, waitFlags, serviceName, "Importing", "imported", "", out)
+	err = waitIfRequested(ctx, client, waitFlags, serviceName, "Importing", "imported", "", out, nil)
 	if err != nil {
 		return err
 	}
@@ -20,7 +20,7 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
-	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"knative.dev/client/pkg/commands"
 	"knative.dev/client/pkg/commands/flags"
@@ -45,6 +45,9 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
   # List service 'web'
   kn service list web
 
+  # List all services and watch for changes, e.g. to see them becoming ready
+  kn service list --watch
+
   # List the services in offline mode instead of kubernetes cluster (Beta)
   kn service list --target=/user/knfiles
   kn service list --target=/user/knfiles/test.json
@@ -60,15 +63,21 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			serviceList, err := getServiceInfo(cmd.Context(), args, client)
+			listConfig, err := serviceListConfig(args)
+			if err != nil {
+				return err
+			}
+			serviceList, err := client.ListServices(cmd.Context(), listConfig...)
 			if err != nil {
 				return err
 			}
 
-			// Stop if nothing found
+			// Stop if nothing found, unless waiting for services to show up
 			if !serviceListFlags.GenericPrintFlags.OutputFlagSpecified() && len(serviceList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No services found.\n")
-				return nil
+				if !serviceListFlags.Watch {
+					return nil
+				}
 			}
 
 			// empty namespace indicates all-namespaces flag is specified
@@ -87,27 +96,29 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 				return a.ObjectMeta.Name < b.ObjectMeta.Name
 			})
 
+			if serviceListFlags.Watch {
+				return serviceListFlags.PrintWatch(cmd.Context(), serviceList, func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+					return client.WatchServices(ctx, resourceVersion, listConfig...)
+				}, cmd.OutOrStdout())
+			}
 			return serviceListFlags.Print(serviceList, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(serviceListCommand.Flags(), true)
 	commands.AddGitOpsFlags(serviceListCommand.Flags())
 	serviceListFlags.AddFlags(serviceListCommand)
+	serviceListFlags.AddWatchFlag(serviceListCommand)
 	return serviceListCommand
 }
 
-func getServiceInfo(ctx context.Context, args []string, client clientservingv1.KnServingClient) (*servingv1.ServiceList, error) {
-	var (
-		serviceList *servingv1.ServiceList
-		err         error
-	)
+// serviceListConfig returns the filter for listing the service given as argument, if any
+func serviceListConfig(args []string) ([]clientservingv1.ListConfig, error) {
 	switch len(args) {
 	case 0:
-		serviceList, err = client.ListServices(ctx)
+		return nil, nil
 	case 1:
-		serviceList, err = client.ListServices(ctx, clientservingv1.WithName(args[0]))
+		return []clientservingv1.ListConfig{clientservingv1.WithName(args[0])}, nil
 	default:
 		return nil, fmt.Errorf("'kn service list' accepts maximum 1 argument")
 	}
-	return serviceList, err
 }
@@ -15,10 +15,13 @@
 package service
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
@@ -187,3 +190,25 @@ func getServiceWithNamespace(name, namespace string) *servingv1.Service {
 	service.Namespace = namespace
 	return &service
 }
+
+func TestServiceListWatchMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	list := &servingv1.ServiceList{Items: []servingv1.Service{*getServiceWithNamespace("svc1", "default")}}
+	list.ResourceVersion = "7"
+	r.ListServices(mock.Any(), list, nil)
+	watcher := watch.NewFakeWithChanSize(2, false)
+	watcher.Add(getServiceWithNamespace("svc2", "default"))
+	watcher.Error(&apierrors.NewForbidden(servingv1.Resource("services"), "", errors.New("not allowed")).ErrStatus)
+	r.WatchServices("7", mock.Any(), watcher, nil)
+
+	output, err := executeServiceCommand(client, "list", "--watch")
+	assert.ErrorContains(t, err, "not allowed")
+	outputLines := strings.Split(output, "\n")
+	assert.Assert(t, util.ContainsAll(outputLines[0], "NAME", "URL", "READY"))
+	assert.Assert(t, util.ContainsAll(outputLines[1], "svc1"))
+	assert.Assert(t, util.ContainsAll(outputLines[2], "svc2"))
+
+	r.Validate()
+}
@@ -0,0 +1,117 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/serving/pkg/apis/serving"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/logs"
+	"knative.dev/client/pkg/output/term"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var logsExample = `
+  # Print the logs of all pods of service 'svc'
+  kn service logs svc
+
+  # Follow the logs of service 'svc', including pods started later on
+  kn service logs svc -f
+
+  # Print the last 20 lines of the last ten minutes of revision 'svc-00002'
+  kn service logs svc --revision svc-00002 --since 10m --tail 20
+
+  # Print the logs of the queue-proxy sidecar
+  kn service logs svc --container queue-proxy`
+
+// NewServiceLogsCommand creates a new command for printing the logs of a service
+func NewServiceLogsCommand(p *commands.KnParams) *cobra.Command {
+	var opts logs.Options
+	var revision string
+
+	command := &cobra.Command{
+		Use:   "logs NAME",
+		Short: "Print the logs of the containers of a service",
+		Long: `Print the logs of the containers of a service
+
+The logs of all pods of the service, or of one of its revisions, are printed
+with the pod and container prefixed to each line. The queue-proxy sidecar is
+skipped unless it's selected with --container.`,
+		Example:           logsExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service logs' requires the service name given as single argument")
+			}
+			if opts.Since < 0 {
+				return fmt.Errorf("--since must not be negative, not %s", opts.Since)
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			// make sure that a missing service isn't mistaken for a service without pods
+			if _, err := client.GetService(cmd.Context(), name); err != nil {
+				return err
+			}
+			kubeClient, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+
+			selector := labels.Set{serving.ServiceLabelKey: name}
+			what := fmt.Sprintf("service '%s'", name)
+			if revision != "" {
+				selector[serving.RevisionLabelKey] = revision
+				what = fmt.Sprintf("revision '%s' of service '%s'", revision, name)
+			}
+			opts.Namespace = namespace
+			opts.LabelSelector = selector.String()
+			opts.Color = term.IsFancy(cmd.OutOrStdout())
+
+			out := cmd.OutOrStdout()
+			if opts.Follow {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Following logs of %s in namespace '%s', press Ctrl-C to stop.\n", what, namespace)
+			}
+			found, err := logs.NewStreamer(kubeClient, opts, out).Stream(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if found == 0 && !opts.Follow {
+				fmt.Fprintf(out, "No pods found for %s in namespace '%s', it might have been scaled to zero.\n", what, namespace)
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVar(&revision, "revision", "", "Only print the logs of the given revision of the service.")
+	command.Flags().StringVarP(&opts.Container, "container", "c", "",
+		"Only print the logs of the given container. By default the logs of all containers except "+clientservingv1.QueueProxyContainerName+" are printed.")
+	command.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "Follow the logs, including those of pods started later on.")
+	command.Flags().DurationVar(&opts.Since, "since", 0, "Only print logs newer than the given duration, like 10m or 1h.")
+	command.Flags().Int64Var(&opts.Tail, "tail", -1, "Number of most recent lines to print per container, all lines if negative.")
+	return command
+}
@@ -0,0 +1,124 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceLogs(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		newServicePod("foo-00001-deployment-1", "foo", "foo-00001"),
+		newServicePod("foo-00002-deployment-1", "foo", "foo-00002"),
+		newServicePod("bar-00001-deployment-1", "bar", "bar-00001"),
+	)
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getService("foo"), nil)
+	r.GetService("foo", getService("foo"), nil)
+	r.GetService("foo", getService("foo"), nil)
+	r.GetService("foo", getService("foo"), nil)
+	r.GetService("baz", nil, apierrors.NewNotFound(servingv1.Resource("service"), "baz"))
+
+	output, err := executeServiceLogsCommand(client, kubeClient, "logs", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "[foo-00001-deployment-1 user-container] fake logs", "[foo-00002-deployment-1 user-container] fake logs"))
+	assert.Assert(t, util.ContainsNone(output, "bar-00001", "queue-proxy"))
+
+	output, err = executeServiceLogsCommand(client, kubeClient, "logs", "foo", "--revision", "foo-00002", "--tail", "5", "--since", "10m")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "[foo-00002-deployment-1 user-container]"))
+	assert.Assert(t, util.ContainsNone(output, "foo-00001"))
+
+	output, err = executeServiceLogsCommand(client, kubeClient, "logs", "foo", "-c", "queue-proxy")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "[foo-00001-deployment-1 queue-proxy]", "[foo-00002-deployment-1 queue-proxy]"))
+	assert.Assert(t, util.ContainsNone(output, "user-container"))
+
+	output, err = executeServiceLogsCommand(client, kubeClient, "logs", "foo", "--revision", "foo-00003")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No pods found", "revision 'foo-00003'", "scaled to zero"))
+
+	_, err = executeServiceLogsCommand(client, kubeClient, "logs", "baz")
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	r.Validate()
+}
+
+func TestServiceLogsErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	kubeClient := fake.NewSimpleClientset()
+
+	_, err := executeServiceLogsCommand(client, kubeClient, "logs")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeServiceLogsCommand(client, kubeClient, "logs", "foo", "--since", "-1m")
+	assert.ErrorContains(t, err, "must not be negative")
+}
+
+func executeServiceLogsCommand(client clientservingv1.KnServingClient, kubeClient kubernetes.Interface, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func newServicePod(name, service, revision string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				serving.ServiceLabelKey:  service,
+				serving.RevisionLabelKey: revision,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "user-container"}, {Name: "queue-proxy"}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "user-container", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "queue-proxy", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+
+	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// podFlags select the pod of a service to connect to
+type podFlags struct {
+	revision  string
+	scaleUp   bool
+	waitFlags commands.WaitFlags
+}
+
+func (f *podFlags) add(command *cobra.Command) {
+	command.Flags().StringVar(&f.revision, "revision", "",
+		"Revision whose pod to connect to. Defaults to the latest ready revision of the service.")
+	command.Flags().BoolVar(&f.scaleUp, "scale-up", false,
+		"Scale up the revision if it doesn't have a ready pod, by setting the "+autoscaling.MinScaleAnnotationKey+
+			" annotation to 1 until the command exits.")
+	// a pod is only waited for when scaling up
+	f.waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "wait", "pod", "ready")
+}
+
+// selectPod returns a ready pod of the selected revision of the service. If the revision
+// has been scaled up, the returned function must be called to restore its min-scale.
+func (f *podFlags) selectPod(ctx context.Context, cmd *cobra.Command, p *commands.KnParams, namespace, name string) (*corev1.Pod, func(), error) {
+	client, err := p.NewServingClient(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	service, err := client.GetService(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision := f.revision
+	if revision == "" {
+		revision = service.Status.LatestReadyRevisionName
+		if revision == "" {
+			return nil, nil, fmt.Errorf("service '%s' doesn't have a ready revision", name)
+		}
+	} else {
+		rev, err := client.GetRevision(ctx, revision)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rev.Labels[serving.ServiceLabelKey] != name {
+			return nil, nil, fmt.Errorf("revision '%s' doesn't belong to service '%s'", revision, name)
+		}
+	}
+
+	kubeClient, err := p.NewKubeClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	pod, err := clientservingv1.ReadyPod(ctx, kubeClient, namespace, revision)
+	if err == nil {
+		return pod, func() {}, nil
+	}
+	var noPod clientservingv1.NoReadyPodError
+	if !errors.As(err, &noPod) {
+		return nil, nil, err
+	}
+	if !f.scaleUp {
+		return nil, nil, fmt.Errorf("%w, it might have been scaled to zero, use --scale-up to start a pod", err)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Scaling up revision '%s' as it doesn't have a ready pod.\n", revision)
+	restoreScale, err := clientservingv1.ScaleUpRevision(ctx, client, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	restore := func() {
+		fmt.Fprintf(out, "Restoring %s of revision '%s'.\n", autoscaling.MinScaleAnnotationKey, revision)
+		// the context of the command might have been cancelled already
+		if err := restoreScale(context.Background()); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+		}
+	}
+	pod, err = clientservingv1.WaitForReadyPod(ctx, kubeClient, namespace, revision, time.Duration(f.waitFlags.TimeoutInSeconds)*time.Second)
+	if err != nil {
+		restore()
+		return nil, nil, err
+	}
+	return pod, restore, nil
+}
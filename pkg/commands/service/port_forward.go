@@ -0,0 +1,108 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+)
+
+var portForwardExample = `
+  # Forward local port 8080 to port 8080 of a pod of the latest ready revision of service 'svc'
+  kn service port-forward svc 8080
+
+  # Forward local port 9000 to port 8080 of a pod of revision 'svc-00002'
+  kn service port-forward svc --revision svc-00002 9000:8080
+
+  # Forward a random local port, starting a pod if the service has been scaled to zero
+  kn service port-forward svc :8080 --scale-up`
+
+// NewServicePortForwardCommand creates a new command for forwarding local ports to a pod of a service
+func NewServicePortForwardCommand(p *commands.KnParams) *cobra.Command {
+	var podFlags podFlags
+
+	command := &cobra.Command{
+		Use:   "port-forward NAME [LOCAL_PORT:]REMOTE_PORT...",
+		Short: "Forward local ports to a pod of a service",
+		Long: `Forward local ports to a pod of a service
+
+The ports are forwarded to a ready pod of the latest ready revision, or of the given
+revision, bypassing the activator and the routing of Knative serving. With --scale-up
+a revision without a ready pod is scaled up while forwarding.`,
+		Example:           portForwardExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("'service port-forward' requires the service name and at least one port as arguments")
+			}
+			name, ports := args[0], args[1:]
+			for _, port := range ports {
+				if err := validatePortMapping(port); err != nil {
+					return err
+				}
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			connector, err := p.NewPodConnector()
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			pod, restore, err := podFlags.selectPod(ctx, cmd, p, namespace, name)
+			if err != nil {
+				return err
+			}
+			defer restore()
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Forwarding to pod '%s' of service '%s' in namespace '%s', press Ctrl-C to stop.\n", pod.Name, name, namespace)
+			return connector.PortForward(ctx, pod, ports, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	podFlags.add(command)
+	return command
+}
+
+// validatePortMapping checks that the port is given as [LOCAL_PORT:]REMOTE_PORT,
+// an empty local port selects a random one
+func validatePortMapping(mapping string) error {
+	local, remote, found := strings.Cut(mapping, ":")
+	if !found {
+		local, remote = "", local
+	}
+	if (local != "" && !isValidPort(local)) || !isValidPort(remote) {
+		return fmt.Errorf("invalid port mapping '%s', expected [LOCAL_PORT:]REMOTE_PORT with ports between 1 and 65535", mapping)
+	}
+	return nil
+}
+
+func isValidPort(port string) bool {
+	number, err := strconv.ParseUint(port, 10, 16)
+	return err == nil && number > 0
+}
@@ -0,0 +1,205 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServicePortForward(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		newReadyServicePod("foo-00001-deployment-1", "foo", "foo-00001"),
+		newReadyServicePod("foo-00002-deployment-1", "foo", "foo-00002"),
+	)
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getPodService("foo", "foo-00002"), nil)
+	r.GetService("foo", getPodService("foo", "foo-00002"), nil)
+	r.GetRevision("foo-00001", getPodRevision("foo", "foo-00001", ""), nil)
+
+	connector := &fakePodConnector{}
+	output, err := executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", "8080", "9000:8080")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Forwarding to pod 'foo-00002-deployment-1'", "service 'foo'", "Ctrl-C"))
+	assert.Equal(t, connector.pod, "foo-00002-deployment-1")
+	assert.DeepEqual(t, connector.ports, []string{"8080", "9000:8080"})
+
+	_, err = executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", "--revision", "foo-00001", ":8080")
+	assert.NilError(t, err)
+	assert.Equal(t, connector.pod, "foo-00001-deployment-1")
+
+	r.Validate()
+}
+
+func TestServicePortForwardScaleUp(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getPodService("foo", "foo-00001"), nil)
+	r.GetService("foo", getPodService("foo", "foo-00001"), nil)
+
+	// without --scale-up, the command fails as there is no ready pod
+	connector := &fakePodConnector{}
+	_, err := executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", "8080")
+	assert.ErrorContains(t, err, "no ready pod found for revision 'foo-00001'")
+	assert.ErrorContains(t, err, "--scale-up")
+
+	r.GetRevision("foo-00001", getPodRevision("foo", "foo-00001", ""), nil)
+	r.UpdateRevision(func(t *testing.T, revision *servingv1.Revision) {
+		assert.Equal(t, revision.Annotations[autoscaling.MinScaleAnnotationKey], "1")
+		_, err := kubeClient.CoreV1().Pods("default").Create(context.Background(),
+			newReadyServicePod("foo-00001-deployment-1", "foo", "foo-00001"), metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}, nil)
+	r.GetRevision("foo-00001", getPodRevision("foo", "foo-00001", "1"), nil)
+	r.UpdateRevision(func(t *testing.T, revision *servingv1.Revision) {
+		_, present := revision.Annotations[autoscaling.MinScaleAnnotationKey]
+		assert.Assert(t, !present)
+	}, nil)
+
+	output, err := executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", "8080", "--scale-up")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Scaling up revision 'foo-00001'", "Forwarding to pod 'foo-00001-deployment-1'",
+		"Restoring "+autoscaling.MinScaleAnnotationKey+" of revision 'foo-00001'"))
+	assert.Equal(t, connector.pod, "foo-00001-deployment-1")
+
+	r.Validate()
+}
+
+func TestServicePortForwardScaleUpTimeout(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getPodService("foo", "foo-00001"), nil)
+	r.GetRevision("foo-00001", getPodRevision("foo", "foo-00001", ""), nil)
+	r.UpdateRevision(mock.Any(), nil)
+	r.GetRevision("foo-00001", getPodRevision("foo", "foo-00001", "1"), nil)
+	r.UpdateRevision(mock.Any(), nil)
+
+	output, err := executeServicePodCommand(client, kubeClient, &fakePodConnector{}, "port-forward", "foo", "8080", "--scale-up", "--wait-timeout", "1")
+	assert.ErrorContains(t, err, "timeout while waiting for a ready pod of revision 'foo-00001'")
+	assert.Assert(t, util.ContainsAll(output, "Restoring "+autoscaling.MinScaleAnnotationKey+" of revision 'foo-00001'"))
+
+	r.Validate()
+}
+
+func TestServicePortForwardErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	kubeClient := fake.NewSimpleClientset()
+	connector := &fakePodConnector{}
+
+	_, err := executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo")
+	assert.ErrorContains(t, err, "requires the service name and at least one port")
+
+	for _, port := range []string{"0", "65536", "http", "8080:", ":", "1:2:3"} {
+		_, err = executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", port)
+		assert.ErrorContains(t, err, "invalid port mapping '"+port+"'")
+	}
+
+	r.GetService("foo", getPodService("foo", ""), nil)
+	_, err = executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", "8080")
+	assert.ErrorContains(t, err, "service 'foo' doesn't have a ready revision")
+
+	r.GetService("foo", getPodService("foo", "foo-00001"), nil)
+	r.GetRevision("bar-00001", getPodRevision("bar", "bar-00001", ""), nil)
+	_, err = executeServicePodCommand(client, kubeClient, connector, "port-forward", "foo", "--revision", "bar-00001", "8080")
+	assert.ErrorContains(t, err, "revision 'bar-00001' doesn't belong to service 'foo'")
+
+	r.Validate()
+}
+
+type fakePodConnector struct {
+	pod   string
+	ports []string
+	exec  clientservingv1.ExecOptions
+}
+
+func (c *fakePodConnector) PortForward(ctx context.Context, pod *corev1.Pod, ports []string, out, errOut io.Writer) error {
+	c.pod, c.ports = pod.Name, ports
+	return nil
+}
+
+func (c *fakePodConnector) Exec(ctx context.Context, pod *corev1.Pod, opts clientservingv1.ExecOptions) error {
+	c.pod, c.exec = pod.Name, opts
+	return nil
+}
+
+func executeServicePodCommand(client clientservingv1.KnServingClient, kubeClient kubernetes.Interface, connector clientservingv1.PodConnector, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	knParams.NewPodConnector = func() (clientservingv1.PodConnector, error) {
+		return connector, nil
+	}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func getPodService(name, latestReadyRevision string) *servingv1.Service {
+	service := getService(name)
+	service.Status.LatestReadyRevisionName = latestReadyRevision
+	return service
+}
+
+func getPodRevision(service, name, minScale string) *servingv1.Revision {
+	revision := &servingv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{serving.ServiceLabelKey: service},
+		},
+	}
+	if minScale != "" {
+		revision.Annotations = map[string]string{autoscaling.MinScaleAnnotationKey: minScale}
+	}
+	return revision
+}
+
+func newReadyServicePod(name, service, revision string) *corev1.Pod {
+	pod := newServicePod(name, service, revision)
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	return pod
+}
@@ -0,0 +1,129 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+)
+
+var previewExample = `
+  # Deploy a new image to service 'svc' without routing traffic to it,
+  # the new revision is reachable with the URL of tag 'candidate'
+  kn service preview svc --image gcr.io/foo/bar:v2
+
+  # Deploy a preview with tag 'green' and promote it after testing
+  kn service preview svc --image gcr.io/foo/bar:v2 --tag green
+  kn service promote svc --tag green`
+
+// NewServicePreviewCommand creates a new command for deploying a preview revision
+func NewServicePreviewCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var image, tag string
+
+	command := &cobra.Command{
+		Use:   "preview NAME --image IMAGE [--tag TAG]",
+		Short: "Deploy a new revision of a service without routing traffic to it",
+		Long: `Deploy a new revision of a service without routing traffic to it
+
+A new revision with the given image is created and tagged, but doesn't receive
+any traffic. It can be reached with the URL of the tag, which is printed when the
+revision is ready. Use 'kn service promote' to route all traffic to it.`,
+		Example:           previewExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service preview' requires the service name given as single argument")
+			}
+			if image == "" {
+				return errors.New("'service preview' requires an image given with --image")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				container := clientserving.ContainerOfRevisionSpec(&service.Spec.Template.Spec)
+				if container == nil {
+					return nil, fmt.Errorf("service '%s' doesn't have a container", name)
+				}
+				targets, err := traffic.Preview(service.Spec.Traffic, tag, service.Status.LatestReadyRevisionName)
+				if err != nil {
+					return nil, fmt.Errorf("cannot create preview of service '%s': %w", name, err)
+				}
+				container.Image = image
+				service.Spec.Template.Name = ""
+				clientserving.UnsetUserImageAnnotation(&service.Spec.Template)
+				clientserving.UpdateTimestampAnnotation(&service.Spec.Template)
+				service.Spec.Traffic = targets
+				return service, nil
+			}
+			if _, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps); err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Service '%s' updated with a preview tagged '%s' in namespace '%s'.\n", name, tag, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Creating preview of Service '%s' in namespace '%s':\n", name, namespace)
+			fmt.Fprintln(out, "")
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			if err := waitForService(cmd.Context(), client, name, out, wconfig); err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+
+			service, err := client.GetService(cmd.Context(), name)
+			if err != nil {
+				return fmt.Errorf("cannot fetch service '%s' in namespace '%s' for extracting the URL: %w", name, namespace, err)
+			}
+			for _, target := range service.Status.Traffic {
+				if target.Tag == tag && target.URL != nil {
+					fmt.Fprintf(out, "Preview of Service '%s' with revision '%s' is available at URL:\n%s\n", name, target.RevisionName, target.URL)
+					return nil
+				}
+			}
+			fmt.Fprintf(out, "Preview of Service '%s' is ready, but no URL has been assigned to tag '%s'.\n", name, tag)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVar(&image, "image", "", "Image of the preview revision.")
+	command.Flags().StringVar(&tag, "tag", "candidate", "Tag of the preview revision, which determines its URL.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "preview", "service", "ready")
+	return command
+}
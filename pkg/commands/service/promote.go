@@ -0,0 +1,120 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+)
+
+var promoteExample = `
+  # Route all traffic of service 'svc' to the revision tagged 'candidate'
+  # and tag the revision which received the traffic before as 'previous'
+  kn service promote svc
+
+  # Promote the revision tagged 'green' and tag the former one as 'blue'
+  kn service promote svc --tag green --previous-tag blue`
+
+// NewServicePromoteCommand creates a new command for promoting a tagged revision
+func NewServicePromoteCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var tag, previousTag string
+
+	command := &cobra.Command{
+		Use:   "promote NAME [--tag TAG]",
+		Short: "Route all traffic of a service to a tagged revision",
+		Long: `Route all traffic of a service to a tagged revision
+
+All traffic is routed to the revision behind the given tag. The revision which
+received most of the traffic before keeps being reachable with the previous tag,
+so that it can be promoted back if needed.`,
+		Example:           promoteExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service promote' requires the service name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			var promoted, previous string
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				if service.Status.LatestCreatedRevisionName != service.Status.LatestReadyRevisionName && tagsLatestRevision(service, tag) {
+					return nil, fmt.Errorf("cannot promote tag '%s' of service '%s' as its latest revision '%s' is not ready",
+						tag, name, service.Status.LatestCreatedRevisionName)
+				}
+				targets, promotedRevision, previousRevision, err := traffic.Promote(service.Spec.Traffic, tag, previousTag, service.Status.LatestReadyRevisionName)
+				if err != nil {
+					return nil, fmt.Errorf("cannot promote tag '%s' of service '%s': %w", tag, name, err)
+				}
+				promoted, previous = promotedRevision, previousRevision
+				service.Spec.Traffic = targets
+				return service, nil
+			}
+			if _, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps); err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if waitFlags.Wait {
+				fmt.Fprintf(out, "Promoting revision '%s' of Service '%s' in namespace '%s':\n", promoted, name, namespace)
+				fmt.Fprintln(out, "")
+				wconfig := clientservingv1.WaitConfig{
+					Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+					ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+				}
+				if err := waitForService(cmd.Context(), client, name, out, wconfig); err != nil {
+					return err
+				}
+				fmt.Fprintln(out, "")
+			}
+			fmt.Fprintf(out, "Service '%s' routes all traffic to revision '%s' with tag '%s' in namespace '%s'.\n", name, promoted, tag, namespace)
+			fmt.Fprintf(out, "Revision '%s' is reachable with tag '%s'.\n", previous, previousTag)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVar(&tag, "tag", "candidate", "Tag of the revision to promote.")
+	command.Flags().StringVar(&previousTag, "previous-tag", "previous", "Tag to assign to the revision which received the traffic before.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "promote", "service", "ready")
+	return command
+}
+
+// tagsLatestRevision returns whether the tag follows the latest revision of the service
+func tagsLatestRevision(service *servingv1.Service, tag string) bool {
+	for _, target := range service.Spec.Traffic {
+		if target.Tag == tag {
+			return target.LatestRevision != nil && *target.LatestRevision
+		}
+	}
+	return false
+}
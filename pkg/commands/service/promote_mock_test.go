@@ -0,0 +1,112 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServicePreviewMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", getPromoteService("foo-00001", "foo-00001", nil), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+			{Tag: "candidate", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0)},
+		})
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	preview := getPromoteService("foo-00002", "foo-00002", nil)
+	url, _ := apis.ParseURL("http://candidate-foo.example.com")
+	preview.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(100)},
+		{Tag: "candidate", RevisionName: "foo-00002", Percent: ptr.Int64(0), URL: url},
+	}
+	r.GetService("foo", preview, nil)
+
+	output, err := executeServiceCommand(client, "preview", "foo", "--image", "gcr.io/foo/bar:v2")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Creating preview", "Ready", "revision 'foo-00002'", "http://candidate-foo.example.com"))
+
+	_, err = executeServiceCommand(client, "preview", "foo")
+	assert.ErrorContains(t, err, "requires an image")
+
+	r.Validate()
+}
+
+func TestServicePromoteMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	traffic := []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		{Tag: "candidate", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0)},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", getPromoteService("foo-00002", "foo-00002", traffic), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{Tag: "previous", RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+			{Tag: "candidate", RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "promote", "foo", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "revision 'foo-00002' with tag 'candidate'", "Revision 'foo-00001' is reachable with tag 'previous'"))
+
+	r.Validate()
+}
+
+func TestServicePromoteNotReadyMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	traffic := []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		{Tag: "candidate", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0)},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", getPromoteService("foo-00002", "foo-00001", traffic), nil)
+	r.GetService("foo", getPromoteService("foo-00001", "foo-00001", traffic[:1]), nil)
+
+	_, err := executeServiceCommand(client, "promote", "foo")
+	assert.ErrorContains(t, err, "latest revision 'foo-00002' is not ready")
+
+	_, err = executeServiceCommand(client, "promote", "foo")
+	assert.ErrorContains(t, err, "tag 'candidate' not present")
+
+	r.Validate()
+}
+
+func getPromoteService(latestCreated, latestReady string, traffic []servingv1.TrafficTarget) *servingv1.Service {
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v1"
+	service.Spec.Traffic = traffic
+	service.Status.LatestCreatedRevisionName = latestCreated
+	service.Status.LatestReadyRevisionName = latestReady
+	return service
+}
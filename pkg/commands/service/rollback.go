@@ -0,0 +1,215 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var rollbackExample = `
+  # Roll back service 'svc' to the configuration of the revision before the latest one
+  kn service rollback svc
+
+  # Roll back service 'svc' by three revisions
+  kn service rollback svc --steps 3
+
+  # Roll back service 'svc' to the configuration of revision 'svc-00002'
+  kn service rollback svc --to-revision svc-00002
+
+  # Roll back service 'svc', but keep its traffic split as it is
+  kn service rollback svc --keep-traffic`
+
+// NewServiceRollbackCommand creates a new command for rolling back a service
+func NewServiceRollbackCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var toRevision string
+	var steps int
+	var keepTraffic bool
+
+	command := &cobra.Command{
+		Use:   "rollback NAME [--to-revision REVISION | --steps N]",
+		Short: "Roll back a service to the configuration of a previous revision",
+		Long: `Roll back a service to the configuration of a previous revision
+
+The template of the service is reconstructed from the selected revision, which
+creates a new revision with the same configuration. By default all traffic is
+routed to this new revision, keeping existing tags at 0%. Use --keep-traffic
+to leave the traffic split of the service untouched.`,
+		Example:           rollbackExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollback' requires the service name given as single argument")
+			}
+			if toRevision != "" && cmd.Flags().Changed("steps") {
+				return errors.New("only one of --to-revision and --steps can be specified")
+			}
+			if steps < 1 {
+				return fmt.Errorf("--steps must be a positive number, not %d", steps)
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			revisions, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(name))
+			if err != nil {
+				return err
+			}
+			revision, err := rollbackRevision(service, revisions, toRevision, steps)
+			if err != nil {
+				return err
+			}
+
+			latestRevisionBeforeUpdate := service.Status.LatestReadyRevisionName
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				service.Spec.Template = rollbackTemplate(service, revision)
+				if !keepTraffic {
+					service.Spec.Traffic = rollbackTraffic(service.Spec.Traffic)
+				}
+				return service, nil
+			}
+			if _, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps); err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Service '%s' rolled back to the configuration of revision '%s' in namespace '%s'.\n", name, revision.Name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Rolling back Service '%s' in namespace '%s' to the configuration of revision '%s':\n", name, namespace, revision.Name)
+			fmt.Fprintln(out, "")
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			if err := waitForService(cmd.Context(), client, name, out, wconfig); err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+			return showUrl(cmd.Context(), client, name, latestRevisionBeforeUpdate, "rolled back", out)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVar(&toRevision, "to-revision", "", "Name of the revision whose configuration should be restored.")
+	command.Flags().IntVar(&steps, "steps", 1, "Number of revisions to go back from the latest revision.")
+	command.Flags().BoolVar(&keepTraffic, "keep-traffic", false, "Keep the traffic split of the service instead of routing all traffic to the rolled back configuration.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "rollback", "service", "ready")
+	return command
+}
+
+// rollbackRevision selects the revision to roll back to, either given by name
+// or by the number of steps to go back from the latest created revision
+func rollbackRevision(service *servingv1.Service, revisions *servingv1.RevisionList, toRevision string, steps int) (*servingv1.Revision, error) {
+	sortRevisions(revisions)
+	names := make([]string, 0, len(revisions.Items))
+	for _, revision := range revisions.Items {
+		names = append(names, revision.Name)
+	}
+	latest := service.Status.LatestCreatedRevisionName
+
+	if toRevision != "" {
+		if toRevision == latest {
+			return nil, fmt.Errorf("revision '%s' is already the latest revision of service '%s'", toRevision, service.Name)
+		}
+		for i := range revisions.Items {
+			if revisions.Items[i].Name == toRevision {
+				return &revisions.Items[i], nil
+			}
+		}
+		return nil, fmt.Errorf("revision '%s' of service '%s' doesn't exist, it might have been garbage collected "+
+			"(available revisions: %s)", toRevision, service.Name, strings.Join(names, ", "))
+	}
+
+	current := -1
+	for i, revision := range revisions.Items {
+		if revision.Name == latest {
+			current = i
+		}
+	}
+	if current < 0 {
+		return nil, fmt.Errorf("cannot find the latest revision '%s' of service '%s'", latest, service.Name)
+	}
+	if current-steps < 0 {
+		return nil, fmt.Errorf("cannot roll back service '%s' by %d revision(s) as only %d older revision(s) exist, "+
+			"older revisions might have been garbage collected (available revisions: %s)",
+			service.Name, steps, current, strings.Join(names, ", "))
+	}
+	return &revisions.Items[current-steps], nil
+}
+
+// rollbackTemplate returns the template of the service reconstructed from the revision.
+// The name of the template is cleared so that a new revision gets created.
+func rollbackTemplate(service *servingv1.Service, revision *servingv1.Revision) servingv1.RevisionTemplateSpec {
+	template := constructServiceFromRevision(service, revision.DeepCopy()).Spec.Template
+	template.Name = ""
+	// labels and annotations maintained by Knative serving can't be set on a template
+	template.Labels = withoutServingKeys(template.Labels)
+	template.Annotations = withoutServingKeys(template.Annotations)
+	clientserving.UpdateTimestampAnnotation(&template)
+	return template
+}
+
+// rollbackTraffic routes all traffic to the latest revision, keeping existing tags at 0%
+func rollbackTraffic(traffic []servingv1.TrafficTarget) []servingv1.TrafficTarget {
+	if len(traffic) == 0 {
+		return traffic
+	}
+	result := []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	for _, target := range traffic {
+		if target.Tag != "" {
+			target.Percent = ptr.Int64(0)
+			result = append(result, target)
+		}
+	}
+	return result
+}
+
+func withoutServingKeys(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		if !strings.HasPrefix(key, serving.GroupName+"/") {
+			result[key] = value
+		}
+	}
+	return result
+}
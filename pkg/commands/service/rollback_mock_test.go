@@ -0,0 +1,162 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceRollbackMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	service := getRollbackService("foo-00003")
+	revisions := getRollbackRevisions("foo", 3)
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		template := updated.Spec.Template
+		assert.Equal(t, template.Name, "")
+		assert.Equal(t, template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+		assert.Equal(t, template.Annotations["custom"], "v2")
+		assert.Assert(t, template.Annotations[clientserving.UpdateTimestampAnnotationKey] != "")
+		assert.Equal(t, template.Annotations[serving.GroupName+"/routes"], "")
+		assert.Equal(t, template.Labels[serving.ConfigurationGenerationLabelKey], "")
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+			{Tag: "stable", RevisionName: "foo-00001", Percent: ptr.Int64(0)},
+		})
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "rollback", "foo", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' rolled back", "revision 'foo-00002'", "default"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackToRevisionWaitMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	service := getRollbackService("foo-00003")
+	revisions := getRollbackRevisions("foo", 3)
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v1")
+		// traffic is kept
+		assert.DeepEqual(t, updated.Spec.Traffic, service.Spec.Traffic)
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "rollback", "foo", "--to-revision", "foo-00001", "--keep-traffic")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling back", "foo-00001", "Ready", "http://foo.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackGarbageCollectedMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	service := getRollbackService("foo-00003")
+	revisions := getRollbackRevisions("foo", 3)
+	// The first revision has been garbage collected
+	revisions.Items = revisions.Items[:2]
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+
+	_, err := executeServiceCommand(client, "rollback", "foo", "--to-revision", "foo-00001")
+	assert.ErrorContains(t, err, "garbage collected")
+	assert.ErrorContains(t, err, "foo-00002, foo-00003")
+
+	_, err = executeServiceCommand(client, "rollback", "foo", "--steps", "2")
+	assert.ErrorContains(t, err, "only 1 older revision(s) exist")
+
+	r.Validate()
+}
+
+func TestServiceRollbackFlagValidation(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "rollback", "foo", "--to-revision", "foo-00001", "--steps", "2")
+	assert.ErrorContains(t, err, "only one of")
+
+	_, err = executeServiceCommand(client, "rollback", "foo", "--steps", "0")
+	assert.ErrorContains(t, err, "positive")
+
+	_, err = executeServiceCommand(client, "rollback")
+	assert.ErrorContains(t, err, "single argument")
+}
+
+func getRollbackService(latestRevision string) *servingv1.Service {
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v3"
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: latestRevision, Percent: ptr.Int64(90)},
+		{Tag: "stable", RevisionName: "foo-00001", Percent: ptr.Int64(10)},
+	}
+	service.Status.LatestCreatedRevisionName = latestRevision
+	service.Status.LatestReadyRevisionName = latestRevision
+	return service
+}
+
+func getRollbackRevisions(service string, count int) *servingv1.RevisionList {
+	list := &servingv1.RevisionList{}
+	// revisions are returned in arbitrary order
+	for i := count; i > 0; i-- {
+		list.Items = append(list.Items, servingv1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      service + "-0000" + strconv.Itoa(i),
+				Namespace: "default",
+				Labels: map[string]string{
+					serving.ServiceLabelKey:                 service,
+					serving.ConfigurationGenerationLabelKey: strconv.Itoa(i),
+				},
+				Annotations: map[string]string{
+					serving.GroupName + "/routes": service,
+					"custom":                      "v" + strconv.Itoa(i),
+				},
+			},
+			Spec: servingv1.RevisionSpec{
+				PodSpec: corev1.PodSpec{
+					Containers: []corev1.Container{{Image: "gcr.io/foo/bar:v" + strconv.Itoa(i)}},
+				},
+			},
+		})
+	}
+	return list
+}
@@ -0,0 +1,417 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+)
+
+// RolloutAnnotationKey is the annotation of a service which holds the state of a rollout in progress
+const RolloutAnnotationKey = "client.knative.dev/rollout"
+
+var rolloutExample = `
+  # Roll out a new image to service 'svc', shifting 10%, 25%, 50% and finally all traffic
+  # to the new revision with two minutes in between
+  kn service rollout svc --image gcr.io/foo/bar:v2 --steps 10,25,50,100 --interval 2m
+
+  # Roll out a new image and abort the rollout when the check script fails
+  kn service rollout svc --image gcr.io/foo/bar:v2 --check ./smoke-test.sh
+
+  # Resume an interrupted rollout of service 'svc'
+  kn service rollout svc --resume
+
+  # Abort the rollout of service 'svc' and restore the traffic split from before the rollout
+  kn service rollout svc --abort`
+
+// rolloutState is the state of a rollout, which is recorded in an annotation
+// of the service so that an interrupted rollout can be resumed or aborted
+type rolloutState struct {
+	// Revision which is rolled out
+	Revision string `json:"revision,omitempty"`
+	// Steps in percent of traffic routed to the revision
+	Steps []int64 `json:"steps"`
+	// Interval to wait between the steps
+	Interval string `json:"interval"`
+	// Step is the index of the last applied step, -1 if none has been applied yet
+	Step int `json:"step"`
+	// PreviousTraffic is the traffic split before the rollout, which is restored when aborting
+	PreviousTraffic []servingv1.TrafficTarget `json:"previousTraffic"`
+}
+
+type rolloutOptions struct {
+	image     string
+	steps     []int64
+	interval  time.Duration
+	check     string
+	resume    bool
+	abort     bool
+	waitFlags commands.WaitFlags
+}
+
+// NewServiceRolloutCommand creates a new command for progressively rolling out a new revision
+func NewServiceRolloutCommand(p *commands.KnParams) *cobra.Command {
+	var opts rolloutOptions
+
+	command := &cobra.Command{
+		Use:   "rollout NAME --image IMAGE | --resume | --abort",
+		Short: "Progressively shift traffic to a new revision of a service",
+		Long: `Progressively shift traffic to a new revision of a service
+
+A new revision with the given image is created without receiving any traffic. Then
+traffic is shifted to the new revision along the given steps, waiting for the given
+interval in between. Before moving on to the next step, the revision must still be
+ready and the check command, if any, must succeed. Otherwise the rollout is aborted
+and the traffic split from before the rollout is restored.
+
+The check command is run by 'sh -c' with the environment variables KN_SERVICE,
+KN_NAMESPACE, KN_REVISION and KN_PERCENT set.
+
+The state of the rollout is recorded in the annotation '` + RolloutAnnotationKey + `'
+of the service. An interrupted rollout can be continued with --resume or reverted
+with --abort. The check command isn't recorded and has to be given again when resuming.`,
+		Example:           rolloutExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollout' requires the service name given as single argument")
+			}
+			if err := opts.validate(cmd); err != nil {
+				return err
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			state, err := rolloutStateOf(service)
+			if err != nil {
+				return err
+			}
+
+			r := &rollout{client: client, name: name, namespace: namespace, opts: opts, out: cmd.OutOrStdout(), errOut: cmd.ErrOrStderr()}
+			switch {
+			case opts.abort:
+				if state == nil {
+					return fmt.Errorf("no rollout of service '%s' in progress", name)
+				}
+				return r.abort(cmd.Context(), state, "")
+			case opts.resume:
+				if state == nil {
+					return fmt.Errorf("no rollout of service '%s' in progress", name)
+				}
+				if r.opts.interval, err = time.ParseDuration(state.Interval); err != nil {
+					return fmt.Errorf("cannot parse interval of rollout of service '%s': %w", name, err)
+				}
+				fmt.Fprintf(r.out, "Resuming rollout of Service '%s' in namespace '%s':\n", name, namespace)
+				fmt.Fprintln(r.out, "")
+				return r.run(cmd.Context(), state)
+			}
+			if state != nil {
+				return fmt.Errorf("rollout of service '%s' is already in progress, "+
+					"use --resume to continue or --abort to revert it", name)
+			}
+			return r.start(cmd.Context(), service)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVar(&opts.image, "image", "", "Image of the new revision to roll out.")
+	command.Flags().Int64SliceVar(&opts.steps, "steps", []int64{10, 25, 50, 100},
+		"Comma separated, ascending percentages of traffic shifted to the new revision. The last step must be 100.")
+	command.Flags().DurationVar(&opts.interval, "interval", time.Minute, "Time to wait between the steps.")
+	command.Flags().StringVar(&opts.check, "check", "",
+		"Command to run before each further step. The rollout is aborted when the command fails.")
+	command.Flags().BoolVar(&opts.resume, "resume", false, "Resume an interrupted rollout.")
+	command.Flags().BoolVar(&opts.abort, "abort", false, "Abort a rollout and restore the traffic split from before the rollout.")
+	// a rollout always waits for the service, so only the timeout and the window can be set
+	opts.waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "wait", "service", "ready")
+	return command
+}
+
+func (o *rolloutOptions) validate(cmd *cobra.Command) error {
+	if o.resume && o.abort {
+		return errors.New("only one of --resume and --abort can be specified")
+	}
+	if o.resume || o.abort {
+		for _, flag := range []string{"image", "steps", "interval"} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("--%s can't be used together with --resume or --abort", flag)
+			}
+		}
+		return nil
+	}
+	if o.image == "" {
+		return errors.New("'service rollout' requires an image given with --image")
+	}
+	if o.interval < 0 {
+		return fmt.Errorf("--interval must not be negative, not %s", o.interval)
+	}
+	return validateRolloutSteps(o.steps)
+}
+
+func validateRolloutSteps(steps []int64) error {
+	if len(steps) == 0 {
+		return errors.New("at least one step must be given with --steps")
+	}
+	for i, step := range steps {
+		if step <= 0 || step > 100 {
+			return fmt.Errorf("step %d%% is out of range, steps must be between 1 and 100", step)
+		}
+		if i > 0 && step <= steps[i-1] {
+			return fmt.Errorf("steps must be in ascending order, but %d%% follows %d%%", step, steps[i-1])
+		}
+	}
+	if steps[len(steps)-1] != 100 {
+		return fmt.Errorf("the last step must be 100%%, not %d%%", steps[len(steps)-1])
+	}
+	return nil
+}
+
+// rolloutStateOf returns the state of the rollout in progress, or nil if there is none
+func rolloutStateOf(service *servingv1.Service) (*rolloutState, error) {
+	value, ok := service.Annotations[RolloutAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	state := &rolloutState{}
+	if err := json.Unmarshal([]byte(value), state); err != nil {
+		return nil, fmt.Errorf("cannot parse annotation %s of service '%s': %w", RolloutAnnotationKey, service.Name, err)
+	}
+	if state.Step >= len(state.Steps) {
+		return nil, fmt.Errorf("invalid step %d in annotation %s of service '%s'", state.Step, RolloutAnnotationKey, service.Name)
+	}
+	return state, nil
+}
+
+func setRolloutState(service *servingv1.Service, state *rolloutState) error {
+	if state == nil {
+		delete(service.Annotations, RolloutAnnotationKey)
+		return nil
+	}
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if service.Annotations == nil {
+		service.Annotations = map[string]string{}
+	}
+	service.Annotations[RolloutAnnotationKey] = string(value)
+	return nil
+}
+
+type rollout struct {
+	client    clientservingv1.KnServingClient
+	name      string
+	namespace string
+	opts      rolloutOptions
+	out       io.Writer
+	errOut    io.Writer
+}
+
+// start creates the new revision without routing traffic to it and starts shifting traffic
+func (r *rollout) start(ctx context.Context, service *servingv1.Service) error {
+	state := &rolloutState{Steps: r.opts.steps, Interval: r.opts.interval.String(), Step: -1}
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		container := clientserving.ContainerOfRevisionSpec(&service.Spec.Template.Spec)
+		if container == nil {
+			return nil, fmt.Errorf("service '%s' doesn't have a container", r.name)
+		}
+		if container.Image == r.opts.image {
+			return nil, fmt.Errorf("service '%s' already uses image '%s'", r.name, r.opts.image)
+		}
+		previous, err := traffic.PinLatestRevision(service.Spec.Traffic, service.Status.LatestReadyRevisionName)
+		if err != nil {
+			return nil, fmt.Errorf("cannot roll out service '%s': %w", r.name, err)
+		}
+		state.PreviousTraffic = previous
+		container.Image = r.opts.image
+		service.Spec.Template.Name = ""
+		clientserving.UnsetUserImageAnnotation(&service.Spec.Template)
+		clientserving.UpdateTimestampAnnotation(&service.Spec.Template)
+		service.Spec.Traffic = previous
+		return service, setRolloutState(service, state)
+	}
+	if _, err := r.client.UpdateServiceWithRetry(ctx, r.name, updateFunc, config.DefaultRetry.Steps); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(r.out, "Rolling out image '%s' to Service '%s' in namespace '%s':\n", r.opts.image, r.name, r.namespace)
+	fmt.Fprintln(r.out, "")
+	if err := r.resolveRevision(ctx, state); err != nil {
+		return err
+	}
+	return r.run(ctx, state)
+}
+
+// resolveRevision waits for the new revision to become ready and records its name in the
+// rollout state. The rollout is aborted if the revision doesn't become ready.
+func (r *rollout) resolveRevision(ctx context.Context, state *rolloutState) error {
+	if err := waitForService(ctx, r.client, r.name, r.out, r.waitConfig()); err != nil {
+		return r.abort(ctx, state, fmt.Sprintf("new revision didn't become ready: %v", err))
+	}
+	service, err := r.client.GetService(ctx, r.name)
+	if err != nil {
+		return r.interrupted(err)
+	}
+	if service.Status.LatestCreatedRevisionName == "" {
+		return r.abort(ctx, state, "the revision to roll out isn't known")
+	}
+	state.Revision = service.Status.LatestCreatedRevisionName
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		return service, setRolloutState(service, state)
+	}
+	if _, err := r.client.UpdateServiceWithRetry(ctx, r.name, updateFunc, config.DefaultRetry.Steps); err != nil {
+		return r.interrupted(err)
+	}
+	fmt.Fprintf(r.out, "Created revision '%s' without traffic.\n", state.Revision)
+	return nil
+}
+
+// run shifts traffic along the steps which haven't been applied yet
+func (r *rollout) run(ctx context.Context, state *rolloutState) error {
+	if state.Revision == "" {
+		// interrupted before the new revision has been recorded
+		if err := r.resolveRevision(ctx, state); err != nil {
+			return err
+		}
+	}
+	for i := state.Step + 1; i < len(state.Steps); i++ {
+		if i > 0 && r.opts.interval > 0 {
+			fmt.Fprintf(r.out, "Waiting %s before the next step.\n", r.opts.interval)
+			select {
+			case <-ctx.Done():
+				return r.interrupted(ctx.Err())
+			case <-time.After(r.opts.interval):
+			}
+		}
+		if reason, err := r.verify(ctx, state, i); err != nil {
+			return r.interrupted(err)
+		} else if reason != "" {
+			return r.abort(ctx, state, reason)
+		}
+
+		state.Step = i
+		final := i == len(state.Steps)-1
+		updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+			latest := state.Revision == service.Status.LatestCreatedRevisionName
+			service.Spec.Traffic = traffic.ShiftTraffic(state.PreviousTraffic, state.Revision, state.Steps[i], latest)
+			if final {
+				return service, setRolloutState(service, nil)
+			}
+			return service, setRolloutState(service, state)
+		}
+		if _, err := r.client.UpdateServiceWithRetry(ctx, r.name, updateFunc, config.DefaultRetry.Steps); err != nil {
+			return r.interrupted(err)
+		}
+		fmt.Fprintf(r.out, "Shifted %d%% of traffic to revision '%s'.\n", state.Steps[i], state.Revision)
+		if err := waitForService(ctx, r.client, r.name, r.out, r.waitConfig()); err != nil {
+			if final {
+				return err
+			}
+			return r.abort(ctx, state, fmt.Sprintf("service didn't become ready: %v", err))
+		}
+	}
+	fmt.Fprintln(r.out, "")
+	return showUrl(ctx, r.client, r.name, "", "rolled out", r.out)
+}
+
+// verify checks whether the rollout can continue with the given step. A reason is returned
+// if the rollout must be aborted, an error if the check itself couldn't be performed.
+func (r *rollout) verify(ctx context.Context, state *rolloutState, step int) (string, error) {
+	revision, err := r.client.GetRevision(ctx, state.Revision)
+	if err != nil {
+		return "", err
+	}
+	if !revision.IsReady() {
+		reason := fmt.Sprintf("revision '%s' is not ready", state.Revision)
+		if ready := revision.Status.GetCondition(apis.ConditionReady); ready != nil && ready.Message != "" {
+			reason += ": " + ready.Message
+		}
+		return reason, nil
+	}
+	if r.opts.check == "" || step == 0 {
+		return "", nil
+	}
+	check := exec.CommandContext(ctx, "sh", "-c", r.opts.check) //nolint:gosec
+	check.Stdout = r.out
+	check.Stderr = r.errOut
+	check.Env = append(os.Environ(),
+		"KN_SERVICE="+r.name,
+		"KN_NAMESPACE="+r.namespace,
+		"KN_REVISION="+state.Revision,
+		"KN_PERCENT="+strconv.FormatInt(state.Steps[step-1], 10))
+	if err := check.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return fmt.Sprintf("check '%s' failed: %v", r.opts.check, err), nil
+	}
+	return "", nil
+}
+
+// abort restores the traffic split from before the rollout and removes the rollout state.
+// If a reason is given, the abort is reported as error.
+func (r *rollout) abort(ctx context.Context, state *rolloutState, reason string) error {
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		service.Spec.Traffic = state.PreviousTraffic
+		return service, setRolloutState(service, nil)
+	}
+	if _, err := r.client.UpdateServiceWithRetry(ctx, r.name, updateFunc, config.DefaultRetry.Steps); err != nil {
+		return fmt.Errorf("cannot abort rollout of service '%s': %w", r.name, err)
+	}
+	if reason != "" {
+		return fmt.Errorf("rollout of service '%s' aborted and traffic split restored, %s", r.name, reason)
+	}
+	fmt.Fprintf(r.out, "Rollout of Service '%s' in namespace '%s' aborted, the traffic split from before the rollout has been restored.\n", r.name, r.namespace)
+	return nil
+}
+
+func (r *rollout) interrupted(err error) error {
+	return fmt.Errorf("rollout of service '%s' interrupted, run 'kn service rollout %s --resume' to continue "+
+		"or 'kn service rollout %s --abort' to revert it: %w", r.name, r.name, r.name, err)
+}
+
+func (r *rollout) waitConfig() clientservingv1.WaitConfig {
+	return clientservingv1.WaitConfig{
+		Timeout:     time.Duration(r.opts.waitFlags.TimeoutInSeconds) * time.Second,
+		ErrorWindow: time.Duration(r.opts.waitFlags.ErrorWindowInSeconds) * time.Second,
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceRolloutMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService("foo-00001", nil), nil)
+	// create the new revision with pinned traffic
+	r.GetService("foo", getRolloutService("foo-00001", nil), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+		state, err := rolloutStateOf(updated)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, state.Steps, []int64{50, 100})
+		assert.Equal(t, state.Step, -1)
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getRolloutService("foo-00002", nil), nil)
+	// record the new revision
+	r.GetService("foo", getRolloutService("foo-00002", nil), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		state, err := rolloutStateOf(updated)
+		assert.NilError(t, err)
+		assert.Equal(t, state.Revision, "foo-00002")
+		assert.Equal(t, state.Step, -1)
+	}, true, nil)
+	// first step
+	r.GetRevision("foo-00002", getRolloutRevision("foo-00002", true), nil)
+	r.GetService("foo", getRolloutService("foo-00002", nil), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(50)},
+			{RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(50)},
+		})
+		state, err := rolloutStateOf(updated)
+		assert.NilError(t, err)
+		assert.Equal(t, state.Revision, "foo-00002")
+		assert.Equal(t, state.Step, 0)
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	// final step
+	r.GetRevision("foo-00002", getRolloutRevision("foo-00002", true), nil)
+	r.GetService("foo", getRolloutService("foo-00002", nil), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		})
+		_, ok := updated.Annotations[RolloutAnnotationKey]
+		assert.Assert(t, !ok)
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v2",
+		"--steps", "50,100", "--interval", "0s", "--check", "true")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling out image 'gcr.io/foo/bar:v2'", "Created revision 'foo-00002'",
+		"Shifted 50% of traffic", "Shifted 100% of traffic", "rolled out", "http://foo.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceRolloutCheckFailedMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	state := &rolloutState{
+		Revision: "foo-00002",
+		Steps:    []int64{50, 100},
+		Interval: "0s",
+		Step:     0,
+		PreviousTraffic: []servingv1.TrafficTarget{
+			{RevisionName: "foo-00001", Percent: ptr.Int64(100)},
+		},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.GetRevision("foo-00002", getRolloutRevision("foo-00002", true), nil)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.DeepEqual(t, updated.Spec.Traffic, state.PreviousTraffic)
+		_, ok := updated.Annotations[RolloutAnnotationKey]
+		assert.Assert(t, !ok)
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--resume", "--check", "false")
+	assert.ErrorContains(t, err, "rollout of service 'foo' aborted")
+	assert.ErrorContains(t, err, "check 'false' failed")
+	assert.Assert(t, util.ContainsAll(output, "Resuming rollout"))
+
+	r.Validate()
+}
+
+func TestServiceRolloutRevisionNotReadyMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	state := &rolloutState{
+		Revision:        "foo-00002",
+		Steps:           []int64{100},
+		Interval:        "0s",
+		Step:            -1,
+		PreviousTraffic: []servingv1.TrafficTarget{{RevisionName: "foo-00001", Percent: ptr.Int64(100)}},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.GetRevision("foo-00002", getRolloutRevision("foo-00002", false), nil)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.UpdateService(mock.Any(), true, nil)
+
+	_, err := executeServiceCommand(client, "rollout", "foo", "--resume")
+	assert.ErrorContains(t, err, "revision 'foo-00002' is not ready: image pull failed")
+
+	r.Validate()
+}
+
+func TestServiceRolloutResumeUnknownRevisionMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	// interrupted after creating the new revision, but before recording it
+	state := &rolloutState{
+		Steps:           []int64{100},
+		Interval:        "0s",
+		Step:            -1,
+		PreviousTraffic: []servingv1.TrafficTarget{{RevisionName: "foo-00001", Percent: ptr.Int64(100)}},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		recorded, err := rolloutStateOf(updated)
+		assert.NilError(t, err)
+		assert.Equal(t, recorded.Revision, "foo-00002")
+	}, true, nil)
+	r.GetRevision("foo-00002", getRolloutRevision("foo-00002", true), nil)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--resume", "--wait-timeout", "10", "--wait-window", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Resuming rollout", "Created revision 'foo-00002'", "Shifted 100% of traffic"))
+
+	r.Validate()
+}
+
+func TestServiceRolloutAbortMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	state := &rolloutState{
+		Revision:        "foo-00002",
+		Steps:           []int64{10, 100},
+		Interval:        "1m0s",
+		Step:            0,
+		PreviousTraffic: []servingv1.TrafficTarget{{RevisionName: "foo-00001", Percent: ptr.Int64(100)}},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+	r.UpdateService(func(t *testing.T, updated *servingv1.Service) {
+		assert.DeepEqual(t, updated.Spec.Traffic, state.PreviousTraffic)
+	}, true, nil)
+	r.GetService("foo", getRolloutService("foo-00002", nil), nil)
+	r.GetService("foo", getRolloutService("foo-00002", state), nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--abort")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rollout of Service 'foo'", "aborted"))
+
+	_, err = executeServiceCommand(client, "rollout", "foo", "--abort")
+	assert.ErrorContains(t, err, "no rollout of service 'foo' in progress")
+
+	_, err = executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v3")
+	assert.ErrorContains(t, err, "already in progress")
+
+	r.Validate()
+}
+
+func TestServiceRolloutInterruptedMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService("foo-00001", nil), nil)
+	r.GetService("foo", getRolloutService("foo-00001", nil), nil)
+	r.UpdateService(mock.Any(), true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", nil, errors.New("connection lost"))
+
+	_, err := executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v2")
+	assert.ErrorContains(t, err, "kn service rollout foo --resume")
+	assert.ErrorContains(t, err, "connection lost")
+
+	r.Validate()
+}
+
+func TestServiceRolloutFlagValidation(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	for _, c := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"rollout", "foo"}, "requires an image"},
+		{[]string{"rollout", "foo", "--resume", "--abort"}, "only one of"},
+		{[]string{"rollout", "foo", "--resume", "--image", "foo"}, "--image can't be used"},
+		{[]string{"rollout", "foo", "--image", "foo", "--steps", "10,50"}, "last step must be 100%"},
+		{[]string{"rollout", "foo", "--image", "foo", "--steps", "50,10,100"}, "ascending order"},
+		{[]string{"rollout", "foo", "--image", "foo", "--steps", "0,100"}, "out of range"},
+		{[]string{"rollout", "foo", "--image", "foo", "--interval", "-1m"}, "must not be negative"},
+	} {
+		_, err := executeServiceCommand(client, c.args...)
+		assert.ErrorContains(t, err, c.expected)
+	}
+}
+
+func getRolloutService(latestRevision string, state *rolloutState) *servingv1.Service {
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v1"
+	service.Status.LatestCreatedRevisionName = latestRevision
+	service.Status.LatestReadyRevisionName = latestRevision
+	if state != nil {
+		value, _ := json.Marshal(state)
+		service.Annotations = map[string]string{RolloutAnnotationKey: string(value)}
+		service.Spec.Traffic = []servingv1.TrafficTarget{
+			{RevisionName: state.Revision, Percent: ptr.Int64(10)},
+			{RevisionName: "foo-00001", Percent: ptr.Int64(90)},
+		}
+	}
+	return service
+}
+
+func getRolloutRevision(name string, ready bool) *servingv1.Revision {
+	condition := apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue}
+	if !ready {
+		condition = apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Message: "image pull failed"}
+	}
+	return &servingv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: servingv1.RevisionStatus{
+			Status: duckv1.Status{Conditions: duckv1.Conditions{condition}},
+		},
+	}
+}
@@ -39,9 +39,19 @@ func NewServiceCommand(p *commands.KnParams) *cobra.Command {
 	serviceCmd.AddCommand(NewServiceDeleteCommand(p))
 	serviceCmd.AddCommand(NewServiceUpdateCommand(p))
 	serviceCmd.AddCommand(NewServiceApplyCommand(p))
+	serviceCmd.AddCommand(NewServiceDiffCommand(p))
 	serviceCmd.AddCommand(NewServiceExportCommand(p))
 	serviceCmd.AddCommand(NewServiceImportCommand(p))
 	serviceCmd.AddCommand(NewServiceWaitCommand(p))
+	serviceCmd.AddCommand(NewServiceRollbackCommand(p))
+	serviceCmd.AddCommand(NewServiceRolloutCommand(p))
+	serviceCmd.AddCommand(NewServicePreviewCommand(p))
+	serviceCmd.AddCommand(NewServicePromoteCommand(p))
+	serviceCmd.AddCommand(NewServiceTrafficCommand(p))
+	serviceCmd.AddCommand(NewServiceLogsCommand(p))
+	serviceCmd.AddCommand(NewServiceEventsCommand(p))
+	serviceCmd.AddCommand(NewServicePortForwardCommand(p))
+	serviceCmd.AddCommand(NewServiceExecCommand(p))
 	return serviceCmd
 }
 
@@ -1533,3 +1533,23 @@ func TestServiceUpdateInitialScaleMock(t *testing.T) {
 
 	r.Validate()
 }
+
+func TestServiceUpdateDryRunMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	service := getService("foo")
+	updated := getService("foo")
+	updated.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "a", Value: "mouse"}}
+	updated.Spec.Template.Annotations = map[string]string{}
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.UpdateService(verifyService(updated, true), true, nil)
+
+	output, err := executeServiceCommand(client, "update", "foo", "--env", "a=mouse", "--dry-run=client")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' updated", "(client dry run)"))
+	assert.Assert(t, util.ContainsNone(output, "Updating"))
+
+	r.Validate()
+}
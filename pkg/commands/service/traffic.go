@@ -0,0 +1,116 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+)
+
+var trafficExample = `
+  # Show the desired and the current traffic split of service 'svc'
+  kn service traffic svc`
+
+// NewServiceTrafficCommand creates a new command for showing the traffic split of a service
+func NewServiceTrafficCommand(p *commands.KnParams) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "traffic NAME",
+		Short: "Show the desired and the current traffic split of a service",
+		Long: `Show the desired and the current traffic split of a service
+
+The traffic split requested in the spec of the service is compared with the split
+in its status, which is the one currently applied. Both are shown as bar charts,
+followed by the targets which don't yet receive the desired share of the traffic,
+e.g. during a rollout or while the latest revision isn't ready.`,
+		Example:           trafficExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service traffic' requires the service name given as single argument")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			revisions, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(service.Name))
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			return describeTraffic(out, service, traffic.RevisionReadiness(revisions.Items), term.IsFancy(out))
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	return command
+}
+
+// describeTraffic writes the desired and the current traffic of the service with their mismatches
+func describeTraffic(w io.Writer, service *servingv1.Service, status traffic.RevisionStatus, fancy bool) error {
+	dw := printers.NewPrefixWriter(w)
+	dw.WriteAttribute("Name", service.Name)
+	dw.WriteAttribute("Namespace", service.Namespace)
+	dw.WriteAttribute("URL", extractURL(service))
+	dw.WriteLine()
+
+	// Targets following the latest revision are desired to route to the latest created one
+	latestRevision := service.Status.LatestCreatedRevisionName
+	desired := make(traffic.ServiceTraffic, 0, len(service.Spec.Traffic))
+	for _, target := range service.Spec.Traffic {
+		if target.LatestRevision != nil && *target.LatestRevision {
+			target.RevisionName = latestRevision
+		}
+		desired = append(desired, target)
+	}
+	section := dw.WriteAttribute("Desired Traffic", "")
+	dw.Flush()
+	desired.WriteGraph(section, status, fancy)
+	dw.WriteLine()
+
+	current := traffic.ServiceTraffic(service.Status.Traffic)
+	section = dw.WriteAttribute("Current Traffic", "")
+	dw.Flush()
+	current.WriteGraph(section, status, fancy)
+	dw.WriteLine()
+
+	mismatches := desired.Mismatches(current, latestRevision)
+	if len(mismatches) == 0 {
+		dw.WriteLine("The current traffic matches the desired traffic.")
+		return dw.Flush()
+	}
+	section = dw.WriteAttribute("Mismatches", "")
+	dw.Flush()
+	for _, mismatch := range mismatches {
+		section.WriteColsLn(mismatch.Target, fmt.Sprintf("desired %d%%, current %d%%", mismatch.Desired, mismatch.Current))
+	}
+	return dw.Flush()
+}
@@ -0,0 +1,84 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceTrafficMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// foo-00003 has been created, but isn't ready yet
+	service := getPromoteService("foo-00003", "foo-00002", []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(90)},
+		{RevisionName: "foo-00001", Tag: "old", Percent: ptr.Int64(10)},
+	})
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(90)},
+		{RevisionName: "foo-00001", Tag: "old", Percent: ptr.Int64(10)},
+	}
+	revisions := &servingv1.RevisionList{Items: []servingv1.Revision{
+		newTrafficRevision("foo-00001", corev1.ConditionTrue, ""),
+		newTrafficRevision("foo-00002", corev1.ConditionTrue, ""),
+		newTrafficRevision("foo-00003", corev1.ConditionUnknown, "Deploying"),
+	}}
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+
+	output, err := executeServiceCommand(client, "traffic", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Name:", "foo", "Namespace:", "default", "Desired Traffic:", "Current Traffic:", "Mismatches:"))
+	assert.Assert(t, cmp.Regexp(`(?m)^\s+#{18}\.\.\s+90%\s+@latest \(foo-00003\)\s+Unknown$`, output))
+	assert.Assert(t, cmp.Regexp(`(?m)^\s+#{18}\.\.\s+90%\s+@latest \(foo-00002\)\s+Ready$`, output))
+	assert.Assert(t, cmp.Regexp(`(?m)^\s+@latest \(foo-00003\)\s+desired 90%, current 0%$`, output))
+	assert.Assert(t, cmp.Regexp(`(?m)^\s+@latest \(foo-00002\)\s+desired 0%, current 90%$`, output))
+	assert.Assert(t, util.ContainsNone(output, "foo-00001 #old  desired"))
+
+	// traffic matches once the latest revision is ready
+	service = service.DeepCopy()
+	service.Status.LatestReadyRevisionName = "foo-00003"
+	service.Status.Traffic[0].RevisionName = "foo-00003"
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	output, err = executeServiceCommand(client, "traffic", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "The current traffic matches the desired traffic."))
+	assert.Assert(t, util.ContainsNone(output, "Mismatches"))
+
+	_, err = executeServiceCommand(client, "traffic")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	r.Validate()
+}
+
+func newTrafficRevision(name string, ready corev1.ConditionStatus, reason string) servingv1.Revision {
+	revision := servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	revision.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: ready, Reason: reason}}
+	return revision
+}
@@ -56,15 +56,23 @@ var updateExample = `
   # rest will automatically be directed to echo-v3 (the remaining revision)
   kn service update svc --traffic stable=50,staging=40
 
+  # Set the traffic as declared in a file, e.g. one kept in version control
+  kn service update svc --traffic-file traffic.yaml
+
   # Update the service in offline mode instead of kubernetes cluster (Beta)
   kn service update gitopstest -n test-ns --env KEY1=VALUE1 --target=/user/knfiles
   kn service update gitopstest --env KEY1=VALUE1 --target=/user/knfiles/test.yaml
-  kn service update gitopstest --env KEY1=VALUE1 --target=/user/knfiles/test.json`
+  kn service update gitopstest --env KEY1=VALUE1 --target=/user/knfiles/test.json
+
+  # Preview the updated service without sending it to the cluster
+  kn service update svc --env KEY1=VALUE1 --dry-run=client -o yaml`
 
 func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
 	var trafficFlags flags.Traffic
+	var dryRunFlags commands.DryRunFlags
+	var diagnoseFlags diagnoseFlags
 	serviceUpdateCommand := &cobra.Command{
 		Use:               "update NAME",
 		Short:             "Update a service",
@@ -74,6 +82,10 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 			if len(args) != 1 {
 				return errors.New("'service update' requires the service name given as single argument")
 			}
+			if err := dryRunFlags.Validate(cmd); err != nil {
+				return err
+			}
+			cmd.SetContext(dryRunFlags.WithContext(cmd.Context()))
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -87,6 +99,8 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 
 			// Use to store the latest revision name
 			var latestRevisionBeforeUpdate string
+			// The service as sent for update, which holds the result of a dry-run
+			var updatedService *servingv1.Service
 			name := args[0]
 
 			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
@@ -116,6 +130,7 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 
 					service.Spec.Traffic = traffic
 				}
+				updatedService = service
 				return service, nil
 			}
 
@@ -126,6 +141,10 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 			}
 			out := cmd.OutOrStdout()
 
+			if dryRunFlags.Enabled() {
+				return printDryRunResult(out, dryRunFlags, updatedService, "updated", namespace)
+			}
+
 			// No need to wait if not changed
 			if !changed {
 				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", args[0], namespace)
@@ -142,6 +161,9 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 				}
 				err := waitForService(cmd.Context(), client, name, out, wconfig)
 				if err != nil {
+					if diagnose := diagnoseFlags.diagnoser(p, client, out); diagnose != nil {
+						diagnose(cmd.Context(), name)
+					}
 					return err
 				}
 				fmt.Fprintln(out, "")
@@ -162,7 +184,10 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddGitOpsFlags(serviceUpdateCommand.Flags())
 	editFlags.AddUpdateFlags(serviceUpdateCommand)
 	waitFlags.AddConditionWaitFlags(serviceUpdateCommand, commands.WaitDefaultTimeout, "update", "service", "ready")
+	diagnoseFlags.add(serviceUpdateCommand)
 	trafficFlags.Add(serviceUpdateCommand)
+	dryRunFlags.Add(serviceUpdateCommand, "service update")
+	dryRunFlags.AddOutputFlags(serviceUpdateCommand)
 	return serviceUpdateCommand
 }
 
@@ -1045,6 +1045,15 @@ func TestServiceUpdateTagDoesNotExist(t *testing.T) {
 	assert.Assert(t, util.ContainsAll(err.Error(), "tag(s)", "foo", "not present", "service", "foo"))
 }
 
+func TestServiceUpdateTrafficFileWithTrafficFlags(t *testing.T) {
+	orig := newEmptyService()
+
+	_, _, _, err := fakeServiceUpdate(orig, []string{
+		"service", "update", "foo", "--traffic-file", "traffic.yaml", "--traffic", "@latest=100", "--no-wait"})
+
+	assert.ErrorContains(t, err, "--traffic-file can't be used together with --traffic, --tag or --untag")
+}
+
 func TestServiceUpdateRevisionListError(t *testing.T) {
 	orig := newEmptyService()
 	orig.Name = "foo-err"
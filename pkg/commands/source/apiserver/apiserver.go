@@ -45,6 +45,14 @@ func newAPIServerSourceClient(p *commands.KnParams, cmd *cobra.Command) (v1.KnAP
 		return nil, err
 	}
 
+	if dir := commands.GitOpsTarget(cmd); dir != "" {
+		client, err := p.NewGitopsSourcesClient(namespace, dir)
+		if err != nil {
+			return nil, err
+		}
+		return client.APIServerSourcesClient(), nil
+	}
+
 	if apiServerSourceClientFactory != nil {
 		config, err := p.GetClientConfig()
 		if err != nil {
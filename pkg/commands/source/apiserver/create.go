@@ -52,7 +52,7 @@ func NewAPIServerCreateCommand(p *commands.KnParams) *cobra.Command {
 
 			namespace := apiSourceClient.Namespace()
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -91,6 +91,7 @@ func NewAPIServerCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	updateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
 	cmd.MarkFlagRequired("resource")
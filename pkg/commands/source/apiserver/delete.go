@@ -57,5 +57,6 @@ func NewAPIServerDeleteCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(deleteCommand.Flags(), false)
+	commands.AddGitOpsFlags(deleteCommand.Flags())
 	return deleteCommand
 }
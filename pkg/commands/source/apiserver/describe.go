@@ -112,6 +112,7 @@ func NewAPIServerDescribeCommand(p *commands.KnParams) *cobra.Command {
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
 	machineReadablePrintFlags.AddFlags(command)
 	return command
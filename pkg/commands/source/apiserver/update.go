@@ -51,7 +51,7 @@ func NewAPIServerUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -112,6 +112,7 @@ func NewAPIServerUpdateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	updateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
 	return cmd
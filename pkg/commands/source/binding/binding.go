@@ -50,6 +50,14 @@ func newSinkBindingClient(p *commands.KnParams, cmd *cobra.Command) (clientsourc
 		return nil, err
 	}
 
+	if dir := commands.GitOpsTarget(cmd); dir != "" {
+		client, err := p.NewGitopsSourcesClient(namespace, dir)
+		if err != nil {
+			return nil, err
+		}
+		return client.SinkBindingClient(), nil
+	}
+
 	if sinkBindingClientFactory != nil {
 		config, err := p.GetClientConfig()
 		if err != nil {
@@ -54,7 +54,7 @@ func NewBindingCreateCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -93,6 +93,7 @@ func NewBindingCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	bindingFlags.addBindingFlags(cmd)
 	sinkFlags.Add(cmd)
 	cmd.MarkFlagRequired("subject")
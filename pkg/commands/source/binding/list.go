@@ -67,6 +67,7 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
+	commands.AddGitOpsFlags(cmd.Flags())
 	listFlags.AddFlags(cmd)
 	return cmd
 }
@@ -46,6 +46,14 @@ func newContainerSourceClient(p *commands.KnParams, cmd *cobra.Command) (v1.KnCo
 		return nil, err
 	}
 
+	if dir := commands.GitOpsTarget(cmd); dir != "" {
+		client, err := p.NewGitopsSourcesClient(namespace, dir)
+		if err != nil {
+			return nil, err
+		}
+		return client.ContainerSourcesClient(), nil
+	}
+
 	if containerSourceClientFactory != nil {
 		config, err := p.GetClientConfig()
 		if err != nil {
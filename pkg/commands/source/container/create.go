@@ -55,7 +55,7 @@ func NewContainerCreateCommand(p *commands.KnParams) *cobra.Command {
 
 			namespace := srcClient.Namespace()
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -84,6 +84,7 @@ func NewContainerCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	podFlags.AddFlags(cmd.Flags())
 	podFlags.AddUpdateFlags(cmd.Flags())
 	sinkFlags.Add(cmd)
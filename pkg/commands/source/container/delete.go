@@ -60,5 +60,6 @@ func NewContainerDeleteCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(deleteCommand.Flags(), false)
+	commands.AddGitOpsFlags(deleteCommand.Flags())
 	return deleteCommand
 }
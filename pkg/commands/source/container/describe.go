@@ -93,6 +93,7 @@ func NewContainerDescribeCommand(p *commands.KnParams) *cobra.Command {
 	}
 	flags := containerDescribe.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
 
 	return containerDescribe
@@ -63,6 +63,7 @@ func NewContainerListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
+	commands.AddGitOpsFlags(listCommand.Flags())
 	listFlags.AddFlags(listCommand)
 	return listCommand
 }
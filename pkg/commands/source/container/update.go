@@ -57,7 +57,7 @@ func NewContainerUpdateCommand(p *commands.KnParams) *cobra.Command {
 
 			namespace := srcClient.Namespace()
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -93,6 +93,7 @@ func NewContainerUpdateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	podFlags.AddFlags(cmd.Flags())
 	podFlags.AddUpdateFlags(cmd.Flags())
 	sinkFlags.Add(cmd)
@@ -100,6 +100,20 @@ func ToSourceList(uList *unstructured.UnstructuredList) *SourceList {
 	return &dsl
 }
 
+// ToPrintable converts an eventing source object or list received as Unstructured
+// object into a Source or SourceList object, for printing it as a table. Other
+// objects are returned as they are.
+func ToPrintable(obj runtime.Object) runtime.Object {
+	switch o := obj.(type) {
+	case *unstructured.UnstructuredList:
+		return ToSourceList(o)
+	case *unstructured.Unstructured:
+		source := toSource(o)
+		return &source
+	}
+	return obj
+}
+
 func getSourceTypeName(source *unstructured.Unstructured) string {
 	return fmt.Sprintf("%s%s.%s",
 		strings.ToLower(source.GetKind()),
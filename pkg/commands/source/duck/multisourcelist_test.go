@@ -42,6 +42,22 @@ func TestToSource(t *testing.T) {
 	assert.Check(t, s.Sink == "")
 }
 
+func TestToPrintable(t *testing.T) {
+	source := newSourceUnstructuredObjWithSink("p1", "sources.knative.dev/v1", "PingSource")
+	printable, ok := ToPrintable(source).(*Source)
+	assert.Assert(t, ok)
+	assert.Check(t, printable.Name == "p1")
+	assert.Check(t, printable.Sink == "ksvc:foo")
+
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*source}}
+	printableList, ok := ToPrintable(list).(*SourceList)
+	assert.Assert(t, ok)
+	assert.Check(t, len(printableList.Items) == 1)
+
+	other := &SourceList{}
+	assert.Check(t, ToPrintable(other) == other)
+}
+
 func TestSinkFromUnstructured(t *testing.T) {
 	s, e := sinkFromUnstructured(newSourceUnstructuredObjWithSink("k1",
 		"sources.knative.dev/v1alpha1", "KafkaSource"))
@@ -15,6 +15,7 @@
 package source
 
 import (
+	"context"
 	"fmt"
 
 	"knative.dev/client/pkg/sources"
@@ -22,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"knative.dev/client/pkg/commands"
 	"knative.dev/client/pkg/commands/flags"
 	"knative.dev/client/pkg/commands/source/duck"
@@ -43,7 +45,10 @@ var listExample = `
   kn source list --type=PingSource
 
   # List PingSource and ApiServerSource types sources
-  kn source list --type=PingSource --type=apiserversource`
+  kn source list --type=PingSource --type=apiserversource
+
+  # List available eventing sources and watch for changes
+  kn source list --watch`
 
 // NewListCommand defines and processes `kn source list`
 func NewListCommand(p *commands.KnParams) *cobra.Command {
@@ -70,6 +75,9 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			sourceList, err := dynamicClient.ListSources(cmd.Context(), filters...)
+			watchSources := func(ctx context.Context, _ string) (watch.Interface, error) {
+				return dynamicClient.WatchSources(ctx, filters...)
+			}
 
 			switch {
 			case knerrors.IsForbiddenError(err):
@@ -77,6 +85,9 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 				if sourceList, err = dynamicClient.ListSourcesUsingGVKs(cmd.Context(), &gvks, filters...); err != nil {
 					return knerrors.GetError(err)
 				}
+				watchSources = func(ctx context.Context, _ string) (watch.Interface, error) {
+					return dynamicClient.WatchSourcesUsingGVKs(ctx, &gvks, filters...)
+				}
 			case err != nil:
 				return knerrors.GetError(err)
 			}
@@ -86,7 +97,9 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 			}
 			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No sources found.\n")
-				return nil
+				if !listFlags.Watch {
+					return nil
+				}
 			}
 
 			if sourceList.GroupVersionKind().Empty() {
@@ -96,6 +109,11 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 			if namespace == "" {
 				listFlags.EnsureWithNamespace()
 			}
+			if listFlags.Watch {
+				// the sources are watched as they are, but printed as table like the list
+				listFlags.ToPrintable = duck.ToPrintable
+				return listFlags.PrintWatch(cmd.Context(), sourceList, watchSources, cmd.OutOrStdout())
+			}
 			printer, err := listFlags.ToPrinter()
 			if err != nil {
 				return nil
@@ -114,6 +132,7 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddWatchFlag(listCommand)
 	filterFlags.Add(listCommand, "source type")
 	return listCommand
 }
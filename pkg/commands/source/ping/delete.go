@@ -52,5 +52,6 @@ func NewPingDeleteCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(pingDeleteCommand.Flags(), false)
+	commands.AddGitOpsFlags(pingDeleteCommand.Flags())
 	return pingDeleteCommand
 }
@@ -68,6 +68,7 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
+	commands.AddGitOpsFlags(listCommand.Flags())
 	listFlags.AddFlags(listCommand)
 	return listCommand
 }
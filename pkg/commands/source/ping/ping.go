@@ -46,6 +46,14 @@ func newPingSourceClient(p *commands.KnParams, cmd *cobra.Command) (clientv1.KnP
 		return nil, err
 	}
 
+	if dir := commands.GitOpsTarget(cmd); dir != "" {
+		client, err := p.NewGitopsSourcesClient(namespace, dir)
+		if err != nil {
+			return nil, err
+		}
+		return client.PingSourcesClient(), nil
+	}
+
 	if pingSourceClientFactory != nil {
 		config, err := p.GetClientConfig()
 		if err != nil {
@@ -61,7 +61,7 @@ func NewSubscriptionCreateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -107,6 +107,7 @@ func NewSubscriptionCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	crefFlag.Add(cmd.Flags())
 	// add subscriber flag as `--sink`
 	subscriberFlag.Add(cmd)
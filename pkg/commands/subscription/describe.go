@@ -94,6 +94,7 @@ func NewSubscriptionDescribeCommand(p *commands.KnParams) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
 	machineReadablePrintFlags.AddFlags(cmd)
 	return cmd
@@ -81,6 +81,7 @@ func NewSubscriptionListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
+	commands.AddGitOpsFlags(listCommand.Flags())
 	listFlags.AddFlags(listCommand)
 	return listCommand
 }
@@ -49,6 +49,14 @@ func newSubscriptionClient(p *commands.KnParams, cmd *cobra.Command) (messagingv
 		return nil, err
 	}
 
+	if dir := commands.GitOpsTarget(cmd); dir != "" {
+		client, err := p.NewGitopsMessagingClient(namespace, dir)
+		if err != nil {
+			return nil, err
+		}
+		return client.SubscriptionsClient(), nil
+	}
+
 	if subscriptionClientFactory != nil {
 		config, err := p.GetClientConfig()
 		if err != nil {
@@ -55,7 +55,7 @@ func NewSubscriptionUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -97,6 +97,7 @@ func NewSubscriptionUpdateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	// add subscriber flag as `--sink`
 	subscriberFlag.Add(cmd)
 	replyFlag.AddWithFlagName(cmd, "sink-reply", "")
@@ -40,7 +40,16 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
   kn trigger create mytrigger --broker default --sink ksvc:mysvc
 
   # Create a trigger to filter events with attribute 'type=dev.knative.foo'
-  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc`,
+  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc
+
+  # Create a trigger to filter events whose type starts with 'dev.knative.' and whose source ends with '.example.com'
+  kn trigger create mytrigger --filter-prefix type=dev.knative. --filter-suffix source=.example.com --sink ksvc:mysvc
+
+  # Create a trigger to filter events with a CloudEvents SQL expression
+  kn trigger create mytrigger --filter-cesql "type LIKE 'com.%' AND source = 'example'" --sink ksvc:mysvc
+
+  # Create a trigger with nested filter expressions, e.g. using 'all', 'any' and 'not', given in a file
+  kn trigger create mytrigger --filters-file filters.yaml --sink ksvc:mysvc`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
@@ -53,12 +62,12 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -86,6 +95,16 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 					Ref: objectRef.Ref,
 					URI: objectRef.URI,
 				})
+			if triggerUpdateFlags.AdvancedFiltersChanged(cmd) {
+				// spec.filters overrides spec.filter, so the exact filters become part of spec.filters
+				advancedFilters, err := triggerUpdateFlags.GetAdvancedFilters(cmd, nil, nil)
+				if err != nil {
+					return fmt.Errorf(
+						"cannot create trigger '%s' "+
+							"because %s", name, err)
+				}
+				triggerBuilder.Filters(nil).SubscriptionsAPIFilters(advancedFilters)
+			}
 
 			err = eventingClient.CreateTrigger(cmd.Context(), triggerBuilder.Build())
 			if err != nil {
@@ -98,6 +117,7 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	triggerUpdateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
 	cmd.MarkFlagRequired("sink")
@@ -16,10 +16,13 @@ package trigger
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	dynamicfake "knative.dev/client/pkg/dynamic/fake"
@@ -102,3 +105,72 @@ func TestTriggerCreateWithoutFilter(t *testing.T) {
 
 	eventingRecorder.Validate()
 }
+
+func TestTriggerCreateAdvancedFilters(t *testing.T) {
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+	})
+
+	eventingRecorder := eventingClient.Recorder()
+	wanted := createTrigger("default", triggerName, nil, "mybroker", "mysvc")
+	wanted.Spec.Filters = []eventingv1.SubscriptionsAPIFilter{
+		{Exact: map[string]string{"type": "dev.knative.foo"}},
+		{Prefix: map[string]string{"source": "dev.knative."}},
+		{Suffix: map[string]string{"subject": ".txt"}},
+		{CESQL: "type LIKE 'dev.%'"},
+	}
+	eventingRecorder.CreateTrigger(wanted, nil)
+
+	out, err := executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--filter", "type=dev.knative.foo", "--filter-prefix", "source=dev.knative.", "--filter-suffix", "subject=.txt",
+		"--filter-cesql", "type LIKE 'dev.%'", "--sink", "ksvc:mysvc")
+	assert.NilError(t, err, "Trigger should be created")
+	assert.Assert(t, util.ContainsAll(out, "Trigger", triggerName, "created", "namespace", "default"))
+
+	_, err = executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--filter-prefix", "source", "--sink", "ksvc:mysvc")
+	assert.ErrorContains(t, err, "Invalid --filter-prefix")
+
+	eventingRecorder.Validate()
+}
+
+func TestTriggerCreateFiltersFile(t *testing.T) {
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+	})
+	file := filepath.Join(t.TempDir(), "filters.yaml")
+	assert.NilError(t, os.WriteFile(file, []byte(`
+- any:
+  - exact:
+      type: dev.knative.foo
+  - not:
+      prefix:
+        source: test.
+`), 0600))
+
+	eventingRecorder := eventingClient.Recorder()
+	wanted := createTrigger("default", triggerName, nil, "mybroker", "mysvc")
+	wanted.Spec.Filters = []eventingv1.SubscriptionsAPIFilter{
+		{Any: []eventingv1.SubscriptionsAPIFilter{
+			{Exact: map[string]string{"type": "dev.knative.foo"}},
+			{Not: &eventingv1.SubscriptionsAPIFilter{Prefix: map[string]string{"source": "test."}}},
+		}},
+		{CESQL: "subject IS NOT NULL"},
+	}
+	eventingRecorder.CreateTrigger(wanted, nil)
+
+	_, err := executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--filters-file", file, "--filter-cesql", "subject IS NOT NULL", "--sink", "ksvc:mysvc")
+	assert.NilError(t, err, "Trigger should be created")
+
+	assert.NilError(t, os.WriteFile(file, []byte("- match: {type: foo}"), 0600))
+	_, err = executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--filters-file", file, "--sink", "ksvc:mysvc")
+	assert.ErrorContains(t, err, "cannot parse filters file")
+
+	eventingRecorder.Validate()
+}
@@ -42,7 +42,7 @@ func NewTriggerDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -56,5 +56,6 @@ func NewTriggerDeleteCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(TriggerDeleteCommand.Flags(), false)
+	commands.AddGitOpsFlags(TriggerDeleteCommand.Flags())
 	return TriggerDeleteCommand
 }
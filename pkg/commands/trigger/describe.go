@@ -16,6 +16,8 @@ package trigger
 
 import (
 	"errors"
+	"maps"
+	"slices"
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -56,7 +58,7 @@ func NewTriggerDescribeCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			// get client
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -108,6 +110,7 @@ func NewTriggerDescribeCommand(p *commands.KnParams) *cobra.Command {
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
 	machineReadablePrintFlags.AddFlags(command)
 	return command
@@ -117,10 +120,7 @@ func writeTrigger(dw printers.PrefixWriter, trigger *v1beta1.Trigger, printDetai
 	commands.WriteMetadata(dw, &trigger.ObjectMeta, printDetails)
 	dw.WriteAttribute("Broker", trigger.Spec.Broker)
 	if trigger.Spec.Filter != nil && trigger.Spec.Filter.Attributes != nil {
-		subWriter := dw.WriteAttribute("Filter", "")
-		for key, value := range trigger.Spec.Filter.Attributes {
-			subWriter.WriteAttribute(key, value)
-		}
+		writeSortedAttributes(dw.WriteAttribute("Filter", ""), trigger.Spec.Filter.Attributes)
 	}
 	if len(trigger.Spec.Filters) > 0 {
 		// Split 'Filter' and 'Filters (experimental)' with new line
@@ -158,29 +158,27 @@ func writeNestedFilters(dw printers.PrefixWriter, filter v1beta1.SubscriptionsAP
 	// Exact map[string]string
 	if len(filter.Exact) > 0 {
 		// create new indentation after name
-		subWriter := dw.WriteAttribute("exact", "")
-		for k, v := range filter.Exact {
-			subWriter.WriteAttribute(k, v)
-		}
+		writeSortedAttributes(dw.WriteAttribute("exact", ""), filter.Exact)
 	}
 	// Prefix map[string]string
 	if len(filter.Prefix) > 0 {
 		// create new indentation after name
-		subWriter := dw.WriteAttribute("prefix", "")
-		for k, v := range filter.Prefix {
-			subWriter.WriteAttribute(k, v)
-		}
+		writeSortedAttributes(dw.WriteAttribute("prefix", ""), filter.Prefix)
 	}
 	// Suffix map[string]string
 	if len(filter.Suffix) > 0 {
 		// create new indentation after name
-		subWriter := dw.WriteAttribute("suffix", "")
-		for k, v := range filter.Suffix {
-			subWriter.WriteAttribute(k, v)
-		}
+		writeSortedAttributes(dw.WriteAttribute("suffix", ""), filter.Suffix)
 	}
 	// CESQL string
 	if filter.CESQL != "" {
 		dw.WriteAttribute("cesql", filter.CESQL)
 	}
 }
+
+// writeSortedAttributes writes the attributes of a filter ordered by their names
+func writeSortedAttributes(dw printers.PrefixWriter, attributes map[string]string) {
+	for _, key := range slices.Sorted(maps.Keys(attributes)) {
+		dw.WriteAttribute(key, attributes[key])
+	}
+}
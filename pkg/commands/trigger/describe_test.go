@@ -130,6 +130,18 @@ func TestWriteNestedFilters(t *testing.T) {
 			expectedOutput: "exact:   \n" +
 				"  type:  example\n",
 		},
+		{
+			name: "Exact filter with multiple attributes",
+			filter: v1beta1.SubscriptionsAPIFilter{
+				Exact: map[string]string{
+					"type":    "example",
+					"source":  "foo",
+					"subject": "bar"}},
+			expectedOutput: "exact:      \n" +
+				"  source:   foo\n" +
+				"  subject:  bar\n" +
+				"  type:     example\n",
+		},
 		{
 			name: "Prefix filter",
 			filter: v1beta1.SubscriptionsAPIFilter{
@@ -0,0 +1,81 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func TestTriggerGitOps(t *testing.T) {
+	dir := t.TempDir()
+
+	out, err := executeTriggerGitOpsCommand("create", "mytrigger", "--broker", "mybroker", "--sink", "ksvc:mysvc", "-n", "test-ns", "--target", dir)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Trigger", "mytrigger", "created", "test-ns"))
+	assert.Assert(t, util.ContainsAll(readFile(t, filepath.Join(dir, "test-ns", "trigger", "mytrigger.yaml")),
+		"kind: Trigger", "broker: mybroker", "name: mysvc"))
+
+	_, err = executeTriggerGitOpsCommand("create", "mytrigger", "--broker", "mybroker", "--sink", "ksvc:mysvc", "-n", "test-ns", "--target", dir)
+	assert.ErrorContains(t, err, "already exists")
+
+	out, err = executeTriggerGitOpsCommand("update", "mytrigger", "--filter", "type=foo", "-n", "test-ns", "--target", dir)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "mytrigger", "updated"))
+
+	out, err = executeTriggerGitOpsCommand("list", "-n", "test-ns", "--target", dir)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "mytrigger", "mybroker", "ksvc:mysvc"))
+
+	out, err = executeTriggerGitOpsCommand("describe", "mytrigger", "-n", "test-ns", "--target", dir)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "mytrigger", "type", "foo"))
+
+	out, err = executeTriggerGitOpsCommand("delete", "mytrigger", "-n", "test-ns", "--target", dir)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "mytrigger", "deleted"))
+
+	_, err = executeTriggerGitOpsCommand("describe", "mytrigger", "-n", "test-ns", "--target", dir)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func executeTriggerGitOpsCommand(args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.Initialize()
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+
+	cmd := NewTriggerCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	return string(content)
+}
@@ -36,13 +36,16 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
   kn trigger list
 
   # List all triggers in JSON output format
-  kn trigger list -o json`,
+  kn trigger list -o json
+
+  # List all triggers and watch for changes
+  kn trigger list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
 			}
-			client, err := p.NewEventingClient(namespace)
+			client, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
@@ -52,7 +55,9 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 			}
 			if !triggerListFlags.GenericPrintFlags.OutputFlagSpecified() && len(triggerList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No triggers found.\n")
-				return nil
+				if !triggerListFlags.Watch {
+					return nil
+				}
 			}
 
 			// empty namespace indicates all-namespaces flag is specified
@@ -60,6 +65,9 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 				triggerListFlags.EnsureWithNamespace()
 			}
 
+			if triggerListFlags.Watch {
+				return triggerListFlags.PrintWatch(cmd.Context(), triggerList, client.WatchTriggers, cmd.OutOrStdout())
+			}
 			err = triggerListFlags.Print(triggerList, cmd.OutOrStdout())
 			if err != nil {
 				return err
@@ -68,6 +76,8 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 		},
 	}
 	commands.AddNamespaceFlags(triggerListCommand.Flags(), true)
+	commands.AddGitOpsFlags(triggerListCommand.Flags())
 	triggerListFlags.AddFlags(triggerListCommand)
+	triggerListFlags.AddWatchFlag(triggerListCommand)
 	return triggerListCommand
 }
@@ -22,7 +22,9 @@ import (
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 
 	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -129,3 +131,33 @@ func TestTriggerListAllNamespace(t *testing.T) {
 
 	eventingRecorder.Validate()
 }
+
+func TestTriggerListWatch(t *testing.T) {
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	trigger1 := createTriggerWithStatusAndGvk("default", "trigger1", map[string]string{"type": "dev.knative.foo"}, "mybroker1", "mysink")
+	trigger1.ResourceVersion = "1"
+	triggerList := &eventingv1.TriggerList{Items: []eventingv1.Trigger{*trigger1}}
+	triggerList.ResourceVersion = "1"
+
+	trigger2 := createTriggerWithStatusAndGvk("default", "trigger2", map[string]string{"type": "dev.knative.bar"}, "mybroker2", "mysink")
+	trigger2.ResourceVersion = "2"
+	watcher := watch.NewFakeWithChanSize(3, false)
+	watcher.Add(trigger2)
+	watcher.Delete(trigger1)
+	watcher.Error(&apierrors.NewForbidden(eventingv1.Resource("triggers"), "", nil).ErrStatus)
+	eventingRecorder.ListTriggers(triggerList, nil)
+	eventingRecorder.WatchTriggers("1", watcher, nil)
+
+	output, err := executeTriggerCommand(eventingClient, nil, "list", "--watch")
+	assert.Assert(t, apierrors.IsForbidden(err))
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "BROKER", "SINK", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "trigger1", "mybroker1"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "trigger2", "mybroker2"))
+	assert.Check(t, util.ContainsAll(outputLines[3], "trigger1", "mybroker1"))
+
+	eventingRecorder.Validate()
+}
@@ -45,6 +45,12 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
   # Remove the filter which key is 'type' from a trigger 'mytrigger'
   kn trigger update mytrigger --filter type-
 
+  # Add a prefix filter for the attribute 'type' and remove the CloudEvents SQL expression of a trigger 'mytrigger'
+  kn trigger update mytrigger --filter-prefix type=dev.knative. --filter-cesql ""
+
+  # Replace the filter expressions of a trigger 'mytrigger' by the ones given in a file
+  kn trigger update mytrigger --filters-file filters.yaml
+
   # Update the sink of a trigger 'mytrigger' to 'ksvc:new-service'
   kn trigger update mytrigger --sink ksvc:new-service
   `,
@@ -60,11 +66,11 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
+			eventingClient, err := commands.NewClientForTarget(cmd, namespace, p.NewGitopsEventingClient, p.NewEventingClient)
 			if err != nil {
 				return err
 			}
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			dynamicClient, err := p.NewDynamicClientForCommand(cmd, namespace)
 			if err != nil {
 				return err
 			}
@@ -76,7 +82,15 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 					return nil, fmt.Errorf(
 						"cannot update trigger '%s' because broker is immutable", name)
 				}
-				if cmd.Flags().Changed("filter") {
+				if triggerUpdateFlags.AdvancedFiltersChanged(cmd) || (cmd.Flags().Changed("filter") && len(trigger.Spec.Filters) > 0) {
+					// spec.filters overrides spec.filter, so the exact filters become part of spec.filters
+					filters, err := triggerUpdateFlags.GetAdvancedFilters(cmd, trigger.Spec.Filters, extractFilters(trigger))
+					if err != nil {
+						return nil, fmt.Errorf(
+							"cannot update trigger '%s' because %w", name, err)
+					}
+					b.Filters(nil).SubscriptionsAPIFilters(filters)
+				} else if cmd.Flags().Changed("filter") {
 					updated, removed, err := triggerUpdateFlags.GetUpdateFilters()
 					if err != nil {
 						return nil, fmt.Errorf(
@@ -106,6 +120,7 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 	}
 
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddGitOpsFlags(cmd.Flags())
 	triggerUpdateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
 
@@ -16,17 +16,28 @@ package trigger
 
 import (
 	"fmt"
+	"os"
+	"reflect"
 
 	"github.com/spf13/cobra"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"sigs.k8s.io/yaml"
 
 	"knative.dev/client/pkg/util"
 )
 
+// advancedFilterFlags are the flags for the filter expressions of spec.filters
+var advancedFilterFlags = []string{"filter-prefix", "filter-suffix", "filter-cesql", "filters-file"}
+
 // TriggerUpdateFlags are flags for create and update a trigger
 type TriggerUpdateFlags struct {
 	Broker       string
 	InjectBroker bool
 	Filters      []string
+	FilterPrefix []string
+	FilterSuffix []string
+	FilterCESQL  string
+	FiltersFile  string
 }
 
 // GetFilters to return a map type of filters
@@ -56,4 +67,137 @@ func (f *TriggerUpdateFlags) Add(cmd *cobra.Command) {
 	}
 
 	cmd.Flags().StringSliceVar(&f.Filters, "filter", nil, "Key-value pair for exact CloudEvent attribute matching against incoming events, e.g type=dev.knative.foo")
+	cmd.Flags().StringSliceVar(&f.FilterPrefix, "filter-prefix", nil, "Key-value pair for matching a CloudEvent attribute against a prefix, e.g. type=dev.knative.")
+	cmd.Flags().StringSliceVar(&f.FilterSuffix, "filter-suffix", nil, "Key-value pair for matching a CloudEvent attribute against a suffix, e.g. source=.example.com")
+	cmd.Flags().StringVar(&f.FilterCESQL, "filter-cesql", "", "CloudEvents SQL expression the incoming events have to match, e.g. \"type LIKE 'com.%'\"")
+	cmd.Flags().StringVar(&f.FiltersFile, "filters-file", "", "Path to a YAML or JSON file with a list of filter expressions, which can be nested "+
+		"with 'all', 'any' and 'not'. The expressions of the file replace the existing ones.")
+}
+
+// AdvancedFiltersChanged returns true if any flag for the filter expressions of spec.filters is given
+func (f *TriggerUpdateFlags) AdvancedFiltersChanged(cmd *cobra.Command) bool {
+	for _, name := range advancedFilterFlags {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAdvancedFilters returns the filter expressions for spec.filters, updated per the flags.
+// As spec.filters overrides spec.filter, the given attributes of spec.filter are moved into an
+// 'exact' expression, unless they have already been overridden by existing expressions or are
+// replaced by the expressions of --filters-file. The values for --filter, --filter-prefix and
+// --filter-suffix are merged into the top-level expression of the same dialect, and an empty
+// --filter-cesql removes the top-level CloudEvents SQL expression.
+func (f *TriggerUpdateFlags) GetAdvancedFilters(cmd *cobra.Command, existing []eventingv1.SubscriptionsAPIFilter,
+	attributes map[string]string) ([]eventingv1.SubscriptionsAPIFilter, error) {
+	filters := make([]eventingv1.SubscriptionsAPIFilter, 0, len(existing))
+	for _, filter := range existing {
+		filters = append(filters, *filter.DeepCopy())
+	}
+	exact := func(filter *eventingv1.SubscriptionsAPIFilter) *map[string]string { return &filter.Exact }
+	if cmd.Flags().Changed("filters-file") {
+		var err error
+		if filters, err = readFiltersFile(f.FiltersFile); err != nil {
+			return nil, err
+		}
+	} else if len(filters) == 0 {
+		filters = mergeFilterDialect(filters, exact, attributes, nil)
+	}
+	if cmd.Flags().Changed("filter") {
+		updated, removed, err := f.parseFilterFlag(cmd, "filter", f.Filters)
+		if err != nil {
+			return nil, err
+		}
+		filters = mergeFilterDialect(filters, exact, updated, removed)
+	}
+	if cmd.Flags().Changed("filter-prefix") {
+		updated, removed, err := f.parseFilterFlag(cmd, "filter-prefix", f.FilterPrefix)
+		if err != nil {
+			return nil, err
+		}
+		filters = mergeFilterDialect(filters, func(filter *eventingv1.SubscriptionsAPIFilter) *map[string]string { return &filter.Prefix }, updated, removed)
+	}
+	if cmd.Flags().Changed("filter-suffix") {
+		updated, removed, err := f.parseFilterFlag(cmd, "filter-suffix", f.FilterSuffix)
+		if err != nil {
+			return nil, err
+		}
+		filters = mergeFilterDialect(filters, func(filter *eventingv1.SubscriptionsAPIFilter) *map[string]string { return &filter.Suffix }, updated, removed)
+	}
+	if cmd.Flags().Changed("filter-cesql") {
+		result := filters[:0]
+		for _, filter := range filters {
+			if !reflect.DeepEqual(filter, eventingv1.SubscriptionsAPIFilter{CESQL: filter.CESQL}) {
+				result = append(result, filter)
+			}
+		}
+		filters = result
+		if f.FilterCESQL != "" {
+			filters = append(filters, eventingv1.SubscriptionsAPIFilter{CESQL: f.FilterCESQL})
+		}
+	}
+	return filters, nil
+}
+
+// parseFilterFlag parses the key-value pairs of a filter flag, which are allowed to remove
+// keys with a trailing '-' on updates
+func (f *TriggerUpdateFlags) parseFilterFlag(cmd *cobra.Command, name string, values []string) (map[string]string, []string, error) {
+	if cmd.Name() != "update" {
+		filters, err := util.MapFromArray(values, "=")
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid --%s: %w", name, err)
+		}
+		return filters, nil, nil
+	}
+	filters, err := util.MapFromArrayAllowingSingles(values, "=")
+	if err != nil {
+		return nil, nil, fmt.Errorf("Invalid --%s: %w", name, err)
+	}
+	return filters, util.ParseMinusSuffix(filters), nil
+}
+
+// mergeFilterDialect merges the values into the top-level filter expression which only uses
+// the given dialect. The expression is added if missing and dropped if it ends up empty.
+func mergeFilterDialect(filters []eventingv1.SubscriptionsAPIFilter, dialect func(*eventingv1.SubscriptionsAPIFilter) *map[string]string,
+	updated map[string]string, removed []string) []eventingv1.SubscriptionsAPIFilter {
+	if len(updated) == 0 && len(removed) == 0 {
+		return filters
+	}
+	index := -1
+	for i := range filters {
+		var only eventingv1.SubscriptionsAPIFilter
+		*dialect(&only) = *dialect(&filters[i])
+		if len(*dialect(&filters[i])) > 0 && reflect.DeepEqual(filters[i], only) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		filters = append(filters, eventingv1.SubscriptionsAPIFilter{})
+		index = len(filters) - 1
+	}
+	values := dialect(&filters[index])
+	if *values == nil {
+		*values = map[string]string{}
+	}
+	util.StringMap(*values).Merge(updated).Remove(removed)
+	if len(*values) == 0 {
+		return append(filters[:index], filters[index+1:]...)
+	}
+	return filters
+}
+
+// readFiltersFile reads a list of filter expressions from a YAML or JSON file
+func readFiltersFile(path string) ([]eventingv1.SubscriptionsAPIFilter, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read filters file: %w", err)
+	}
+	var filters []eventingv1.SubscriptionsAPIFilter
+	if err := yaml.UnmarshalStrict(data, &filters); err != nil {
+		return nil, fmt.Errorf("cannot parse filters file '%s': %w", path, err)
+	}
+	return filters, nil
 }
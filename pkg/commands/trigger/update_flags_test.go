@@ -15,10 +15,14 @@
 package trigger
 
 import (
+	"os"
+	"path/filepath"
 	"sort"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 )
 
 func TestGetFilters(t *testing.T) {
@@ -124,3 +128,98 @@ func TestGetUpdateFilters(t *testing.T) {
 		assert.ErrorContains(t, err, "duplicate")
 	})
 }
+
+func TestGetAdvancedFilters(t *testing.T) {
+	existing := []eventingv1.SubscriptionsAPIFilter{
+		{Exact: map[string]string{"type": "foo"}},
+		{Prefix: map[string]string{"source": "dev."}, Suffix: map[string]string{"source": ".com"}},
+		{CESQL: "subject IS NOT NULL"},
+	}
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		existing   []eventingv1.SubscriptionsAPIFilter
+		attributes map[string]string
+		expected   []eventingv1.SubscriptionsAPIFilter
+	}{
+		{
+			name:       "move attributes of spec.filter",
+			args:       []string{"--filter-prefix", "source=dev."},
+			attributes: map[string]string{"type": "foo"},
+			expected: []eventingv1.SubscriptionsAPIFilter{
+				{Exact: map[string]string{"type": "foo"}},
+				{Prefix: map[string]string{"source": "dev."}},
+			},
+		},
+		{
+			name:       "ignore overridden attributes of spec.filter",
+			args:       []string{"--filter-cesql", "type = 'bar'"},
+			existing:   existing,
+			attributes: map[string]string{"source": "ignored"},
+			expected: []eventingv1.SubscriptionsAPIFilter{
+				existing[0],
+				existing[1],
+				{CESQL: "type = 'bar'"},
+			},
+		},
+		{
+			name:     "merge into top-level expression of the dialect",
+			args:     []string{"--filter", "type-", "--filter", "subject=bar", "--filter-prefix", "type=dev."},
+			existing: existing,
+			expected: []eventingv1.SubscriptionsAPIFilter{
+				{Exact: map[string]string{"subject": "bar"}},
+				existing[1],
+				existing[2],
+				{Prefix: map[string]string{"type": "dev."}},
+			},
+		},
+		{
+			name:     "remove expressions",
+			args:     []string{"--filter", "type-", "--filter-cesql", ""},
+			existing: existing,
+			expected: []eventingv1.SubscriptionsAPIFilter{existing[1]},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var flags TriggerUpdateFlags
+			cmd := newFiltersTestCommand(&flags, tc.args)
+			filters, err := flags.GetAdvancedFilters(cmd, tc.existing, tc.attributes)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, filters, tc.expected)
+		})
+	}
+
+	t.Run("replace by filters file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "filters.json")
+		assert.NilError(t, os.WriteFile(file, []byte(`[{"not": {"exact": {"type": "foo"}}}]`), 0600))
+		var flags TriggerUpdateFlags
+		cmd := newFiltersTestCommand(&flags, []string{"--filters-file", file})
+		assert.Assert(t, flags.AdvancedFiltersChanged(cmd))
+		filters, err := flags.GetAdvancedFilters(cmd, existing, map[string]string{"type": "ignored"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, filters, []eventingv1.SubscriptionsAPIFilter{
+			{Not: &eventingv1.SubscriptionsAPIFilter{Exact: map[string]string{"type": "foo"}}},
+		})
+		// the existing filters are left untouched
+		assert.Equal(t, existing[0].Exact["type"], "foo")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var flags TriggerUpdateFlags
+		cmd := newFiltersTestCommand(&flags, []string{"--filters-file", filepath.Join(t.TempDir(), "missing.yaml")})
+		_, err := flags.GetAdvancedFilters(cmd, nil, nil)
+		assert.ErrorContains(t, err, "cannot read filters file")
+
+		cmd = newFiltersTestCommand(&flags, []string{"--filter-suffix", "=value"})
+		_, err = flags.GetAdvancedFilters(cmd, nil, nil)
+		assert.ErrorContains(t, err, "Invalid --filter-suffix")
+	})
+}
+
+func newFiltersTestCommand(flags *TriggerUpdateFlags, args []string) *cobra.Command {
+	cmd := &cobra.Command{Use: "update", Run: func(cmd *cobra.Command, args []string) {}}
+	flags.Add(cmd)
+	cmd.SetArgs(args)
+	cmd.Execute()
+	return cmd
+}
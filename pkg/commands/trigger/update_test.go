@@ -21,6 +21,7 @@ import (
 
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	dynamicfake "knative.dev/client/pkg/dynamic/fake"
@@ -49,6 +50,42 @@ func TestTriggerUpdate(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestTriggerUpdateAdvancedFilters(t *testing.T) {
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	// the exact filters of spec.filter are moved to spec.filters, which overrides them
+	present := createTrigger("default", triggerName, map[string]string{"type": "dev.knative.foo"}, "mybroker", "mysvc")
+	updated := createTrigger("default", triggerName, nil, "mybroker", "mysvc")
+	updated.Spec.Filters = []eventingv1.SubscriptionsAPIFilter{
+		{Exact: map[string]string{"type": "dev.knative.foo"}},
+		{Suffix: map[string]string{"source": ".example.com"}},
+		{CESQL: "subject IS NOT NULL"},
+	}
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	eventingRecorder.UpdateTrigger(updated, nil)
+
+	out, err := executeTriggerCommand(eventingClient, nil, "update", triggerName,
+		"--filter-suffix", "source=.example.com", "--filter-cesql", "subject IS NOT NULL")
+	assert.NilError(t, err, "Trigger should be updated")
+	assert.Assert(t, util.ContainsAll(out, "Trigger", triggerName, "updated", "namespace", "default"))
+
+	// exact filters and the CloudEvents SQL expression are removed from spec.filters
+	present = updated.DeepCopy()
+	updated = createTrigger("default", triggerName, nil, "mybroker", "mysvc")
+	updated.Spec.Filters = []eventingv1.SubscriptionsAPIFilter{
+		{Suffix: map[string]string{"source": ".example.com", "subject": ".txt"}},
+	}
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	eventingRecorder.UpdateTrigger(updated, nil)
+
+	_, err = executeTriggerCommand(eventingClient, nil, "update", triggerName,
+		"--filter", "type-", "--filter-suffix", "subject=.txt", "--filter-cesql", "")
+	assert.NilError(t, err, "Trigger should be updated")
+
+	eventingRecorder.Validate()
+}
+
 func TestTriggerUpdateWithError(t *testing.T) {
 	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
 	eventingRecorder := eventingClient.Recorder()
@@ -18,6 +18,8 @@ import (
 	"io"
 	"os"
 
+	"github.com/spf13/cobra"
+
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/client/pkg/k8s"
 
@@ -48,6 +50,7 @@ type KnParams struct {
 	k8s.Params
 	Output                   io.Writer
 	NewKubeClient            func() (kubernetes.Interface, error)
+	NewPodConnector          func() (clientservingv1.PodConnector, error)
 	NewServingClient         func(namespace string) (clientservingv1.KnServingClient, error)
 	NewServingV1beta1Client  func(namespace string) (clientservingv1beta1.KnServingClient, error)
 	NewGitopsServingClient   func(namespace string, dir string) (clientservingv1.KnServingClient, error)
@@ -56,9 +59,17 @@ type KnParams struct {
 	NewMessagingClient       func(namespace string) (clientmessagingv1.KnMessagingClient, error)
 	NewDynamicClient         func(namespace string) (clientdynamic.KnDynamicClient, error)
 	NewEventingV1beta2Client func(namespace string) (clienteventingv1beta2.KnEventingV1Beta2Client, error)
+	NewPrompter              func(cmd *cobra.Command) (Prompter, error)
+
+	NewGitopsServingV1beta1Client func(namespace string, dir string) (clientservingv1beta1.KnServingClient, error)
+	NewGitopsSourcesClient        func(namespace string, dir string) (clientsourcesv1.KnSourcesClient, error)
+	NewGitopsEventingClient       func(namespace string, dir string) (clienteventingv1.KnEventingClient, error)
+	NewGitopsMessagingClient      func(namespace string, dir string) (clientmessagingv1.KnMessagingClient, error)
 
 	// General global options
 	LogHTTP bool
+	// Input enables prompting for missing arguments and flags
+	Input bool
 
 	// Set this if you want to nail down the namespace
 	fixedCurrentNamespace string
@@ -73,6 +84,10 @@ func (params *KnParams) Initialize() {
 		params.NewKubeClient = params.newKubeClient
 	}
 
+	if params.NewPodConnector == nil {
+		params.NewPodConnector = params.newPodConnector
+	}
+
 	if params.NewServingClient == nil {
 		params.NewServingClient = params.newServingClient
 	}
@@ -104,6 +119,26 @@ func (params *KnParams) Initialize() {
 	if params.NewEventingV1beta2Client == nil {
 		params.NewEventingV1beta2Client = params.newEventingV1Beta2Client
 	}
+
+	if params.NewGitopsServingV1beta1Client == nil {
+		params.NewGitopsServingV1beta1Client = params.newGitopsServingClientV1beta1
+	}
+
+	if params.NewGitopsSourcesClient == nil {
+		params.NewGitopsSourcesClient = params.newGitopsSourcesClient
+	}
+
+	if params.NewGitopsEventingClient == nil {
+		params.NewGitopsEventingClient = params.newGitopsEventingClient
+	}
+
+	if params.NewGitopsMessagingClient == nil {
+		params.NewGitopsMessagingClient = params.newGitopsMessagingClient
+	}
+
+	if params.NewPrompter == nil {
+		params.NewPrompter = params.newPrompter
+	}
 }
 
 func (params *KnParams) newKubeClient() (kubernetes.Interface, error) {
@@ -120,6 +155,14 @@ func (params *KnParams) newKubeClient() (kubernetes.Interface, error) {
 	return client, nil
 }
 
+func (params *KnParams) newPodConnector() (clientservingv1.PodConnector, error) {
+	restConfig, err := params.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	return clientservingv1.NewPodConnector(restConfig)
+}
+
 func (params *KnParams) newServingClient(namespace string) (clientservingv1.KnServingClient, error) {
 	restConfig, err := params.RestConfig()
 	if err != nil {
@@ -150,6 +193,22 @@ func (params *KnParams) newGitopsServingClient(namespace string, dir string) (cl
 	return clientservingv1.NewKnServingGitOpsClient(namespace, dir), nil
 }
 
+func (params *KnParams) newGitopsServingClientV1beta1(namespace string, dir string) (clientservingv1beta1.KnServingClient, error) {
+	return clientservingv1beta1.NewKnServingGitOpsClient(namespace, dir), nil
+}
+
+func (params *KnParams) newGitopsSourcesClient(namespace string, dir string) (clientsourcesv1.KnSourcesClient, error) {
+	return clientsourcesv1.NewKnSourcesGitOpsClient(namespace, dir), nil
+}
+
+func (params *KnParams) newGitopsEventingClient(namespace string, dir string) (clienteventingv1.KnEventingClient, error) {
+	return clienteventingv1.NewKnEventingGitOpsClient(namespace, dir), nil
+}
+
+func (params *KnParams) newGitopsMessagingClient(namespace string, dir string) (clientmessagingv1.KnMessagingClient, error) {
+	return clientmessagingv1.NewKnMessagingGitOpsClient(namespace, dir), nil
+}
+
 func (params *KnParams) newSourcesClient(namespace string) (clientsourcesv1.KnSourcesClient, error) {
 	restConfig, err := params.RestConfig()
 	if err != nil {
@@ -200,6 +259,15 @@ func (params *KnParams) newDynamicClient(namespace string) (clientdynamic.KnDyna
 	return clientdynamic.NewKnDynamicClient(client, namespace), nil
 }
 
+// NewDynamicClientForCommand returns a dynamic client for resolving references, or nil
+// if the command works on a local directory given with --target instead of a cluster
+func (params *KnParams) NewDynamicClientForCommand(cmd *cobra.Command, namespace string) (clientdynamic.KnDynamicClient, error) {
+	if GitOpsTarget(cmd) != "" {
+		return nil, nil
+	}
+	return params.NewDynamicClient(namespace)
+}
+
 // RestConfig returns REST config, which can be to use to create specific clientset
 func (params *KnParams) RestConfig() (*rest.Config, error) {
 	var err error
@@ -368,6 +368,10 @@ func TestInitialize(t *testing.T) {
 	assert.Assert(t, params.NewMessagingClient != nil)
 	assert.Assert(t, params.NewDynamicClient != nil)
 	assert.Assert(t, params.NewEventingV1beta2Client != nil)
+	assert.Assert(t, params.NewGitopsServingV1beta1Client != nil)
+	assert.Assert(t, params.NewGitopsSourcesClient != nil)
+	assert.Assert(t, params.NewGitopsEventingClient != nil)
+	assert.Assert(t, params.NewGitopsMessagingClient != nil)
 
 	basic, err := clientcmd.NewClientConfigFromBytes([]byte(BASIC_KUBECONFIG))
 	if err != nil {
@@ -399,4 +403,20 @@ func TestInitialize(t *testing.T) {
 	eventingBeta1Client, err := params.NewEventingV1beta2Client("mockNamespace")
 	assert.NilError(t, err)
 	assert.Assert(t, eventingBeta1Client != nil)
+
+	gitOpsEventingClient, err := params.NewGitopsEventingClient("mockNamespace", "mockDir")
+	assert.NilError(t, err)
+	assert.Equal(t, gitOpsEventingClient.Namespace(), "mockNamespace")
+
+	gitOpsSourcesClient, err := params.NewGitopsSourcesClient("mockNamespace", "mockDir")
+	assert.NilError(t, err)
+	assert.Equal(t, gitOpsSourcesClient.PingSourcesClient().Namespace(), "mockNamespace")
+
+	gitOpsMessagingClient, err := params.NewGitopsMessagingClient("mockNamespace", "mockDir")
+	assert.NilError(t, err)
+	assert.Equal(t, gitOpsMessagingClient.ChannelsClient().Namespace(), "mockNamespace")
+
+	gitOpsServingV1beta1Client, err := params.NewGitopsServingV1beta1Client("mockNamespace", "mockDir")
+	assert.NilError(t, err)
+	assert.Equal(t, gitOpsServingV1beta1Client.Namespace(), "mockNamespace")
 }
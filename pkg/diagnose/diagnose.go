@@ -0,0 +1,350 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagnose explains why the latest revision of a service doesn't become ready
+package diagnose
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"knative.dev/serving/pkg/apis/serving"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// Reasons of findings
+const (
+	ReasonImagePull         = "ImagePullFailed"
+	ReasonCrashLoop         = "CrashLoop"
+	ReasonOOMKilled         = "OOMKilled"
+	ReasonContainerConfig   = "ContainerConfigError"
+	ReasonUnschedulable     = "Unschedulable"
+	ReasonReadinessProbe    = "ReadinessProbeFailed"
+	ReasonAdmissionRejected = "AdmissionRejected"
+	ReasonPodCreationFailed = "PodCreationFailed"
+	ReasonProgressDeadline  = "ProgressDeadlineExceeded"
+	ReasonRevisionNotReady  = "RevisionNotReady"
+	ReasonServiceNotReady   = "ServiceNotReady"
+)
+
+// explanations of the reasons, printed along with the findings
+var explanations = map[string]string{
+	ReasonImagePull:         "The container image can't be pulled. Check the image name and tag, and the pull secrets of private registries.",
+	ReasonCrashLoop:         "The container exits right after starting. Check its logs and that it listens on the port given by $PORT.",
+	ReasonOOMKilled:         "The container has been killed as it exceeded its memory limit. Raise the limit or reduce the memory usage.",
+	ReasonContainerConfig:   "The container can't be created from its configuration. Check that referenced secrets and config maps exist.",
+	ReasonUnschedulable:     "The pod can't be scheduled to a node. Check the requested resources and the capacity of the cluster.",
+	ReasonReadinessProbe:    "The container is running, but its readiness probe fails. Check the probe and the port the container listens on.",
+	ReasonAdmissionRejected: "An admission webhook rejects the pods of the revision. Check the policies applied to the namespace.",
+	ReasonPodCreationFailed: "The pods of the revision can't be created, e.g. because a resource quota has been exceeded.",
+	ReasonProgressDeadline:  "The deployment of the revision hasn't made progress in time.",
+	ReasonRevisionNotReady:  "The revision isn't ready.",
+	ReasonServiceNotReady:   "The service isn't ready and doesn't have a revision yet.",
+}
+
+// waitingReasons map reasons of waiting containers to the reasons of findings
+var waitingReasons = map[string]string{
+	"ErrImagePull":               ReasonImagePull,
+	"ImagePullBackOff":           ReasonImagePull,
+	"InvalidImageName":           ReasonImagePull,
+	"ErrImageNeverPull":          ReasonImagePull,
+	"CreateContainerConfigError": ReasonContainerConfig,
+	"CreateContainerError":       ReasonContainerConfig,
+	"CrashLoopBackOff":           ReasonCrashLoop,
+}
+
+// Finding is a single cause for a revision not becoming ready
+type Finding struct {
+	// Reason categorizes the finding, like ImagePullFailed
+	Reason string
+	// Object is the kind and name of the object the finding is about, like Pod/foo-00001-deployment-5b8c
+	Object string
+	// Container is the container of the pod the finding is about, if any
+	Container string
+	// Message is the message reported by Kubernetes or Knative serving
+	Message string
+	// Logs are the last log lines of a crashed container
+	Logs []string
+}
+
+// Diagnosis holds the findings for the latest created revision of a service
+type Diagnosis struct {
+	Service  string
+	Revision string
+	Findings []Finding
+}
+
+// Diagnoser inspects a service and the objects owned by its latest created revision
+type Diagnoser struct {
+	client clientservingv1.KnServingClient
+	kube   kubernetes.Interface
+
+	// LogLines is the number of log lines of crashed containers to include
+	LogLines int64
+}
+
+// NewDiagnoser creates a diagnoser using the given clients
+func NewDiagnoser(client clientservingv1.KnServingClient, kube kubernetes.Interface) *Diagnoser {
+	return &Diagnoser{client: client, kube: kube, LogLines: 10}
+}
+
+// Diagnose looks for the causes of the latest created revision of the service not becoming
+// ready, by inspecting the revision, its deployment, replica sets and pods. The most
+// specific findings come first, the condition of the revision last.
+func (d *Diagnoser) Diagnose(ctx context.Context, name string) (*Diagnosis, error) {
+	service, err := d.client.GetService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	diagnosis := &Diagnosis{Service: name, Revision: service.Status.LatestCreatedRevisionName}
+	if diagnosis.Revision == "" {
+		if cond := notReady(service.Status.GetCondition(apis.ConditionReady)); cond != nil {
+			diagnosis.add(Finding{Reason: ReasonServiceNotReady, Object: "Service/" + name, Message: cond.Message})
+		}
+		return diagnosis, nil
+	}
+
+	revision, err := d.client.GetRevision(ctx, diagnosis.Revision)
+	if err != nil {
+		return nil, err
+	}
+	namespace := d.client.Namespace()
+	selector := metav1.ListOptions{LabelSelector: labels.Set{serving.RevisionLabelKey: diagnosis.Revision}.String()}
+
+	pods, err := d.kube.CoreV1().Pods(namespace).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.diagnosePods(ctx, diagnosis, pods.Items); err != nil {
+		return nil, err
+	}
+
+	replicaSets, err := d.kube.AppsV1().ReplicaSets(namespace).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, replicaSet := range replicaSets.Items {
+		diagnoseReplicaSet(diagnosis, &replicaSet)
+	}
+	deployments, err := d.kube.AppsV1().Deployments(namespace).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		for _, cond := range deployment.Status.Conditions {
+			if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+				diagnosis.add(Finding{Reason: ReasonProgressDeadline, Object: "Deployment/" + deployment.Name, Message: cond.Message})
+			}
+		}
+	}
+
+	if cond := notReady(revision.Status.GetCondition(apis.ConditionReady)); cond != nil {
+		message := cond.Message
+		switch {
+		case message == "":
+			message = cond.Reason
+		case cond.Reason != "":
+			message = cond.Reason + ": " + message
+		}
+		diagnosis.add(Finding{Reason: ReasonRevisionNotReady, Object: "Revision/" + revision.Name, Message: message})
+	}
+	return diagnosis, nil
+}
+
+// diagnosePods reports the problems of the containers of the pods. The same problem of
+// a container is only reported for the first pod it's found in.
+func (d *Diagnoser) diagnosePods(ctx context.Context, diagnosis *Diagnosis, pods []corev1.Pod) error {
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+	var probeFailures map[string]string
+	for i := range pods {
+		pod := &pods[i]
+		object := "Pod/" + pod.Name
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable &&
+				!diagnosis.has(ReasonUnschedulable, "") {
+				diagnosis.add(Finding{Reason: ReasonUnschedulable, Object: object, Message: cond.Message})
+			}
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			finding := Finding{Object: object, Container: status.Name}
+			terminated := status.LastTerminationState.Terminated
+			if terminated == nil {
+				terminated = status.State.Terminated
+			}
+			switch {
+			case clientservingv1.IsOOMKilled(status.LastTerminationState) || clientservingv1.IsOOMKilled(status.State):
+				finding.Reason = ReasonOOMKilled
+				finding.Message = fmt.Sprintf("Killed after running out of memory, restarted %d times", status.RestartCount)
+			case status.State.Waiting != nil && waitingReasons[status.State.Waiting.Reason] != "":
+				finding.Reason = waitingReasons[status.State.Waiting.Reason]
+				finding.Message = status.State.Waiting.Message
+				if finding.Reason == ReasonCrashLoop && terminated != nil {
+					finding.Message = fmt.Sprintf("Exited with code %d, restarted %d times", terminated.ExitCode, status.RestartCount)
+				}
+			case terminated != nil && terminated.ExitCode != 0:
+				finding.Reason = ReasonCrashLoop
+				finding.Message = fmt.Sprintf("Exited with code %d, restarted %d times", terminated.ExitCode, status.RestartCount)
+			case status.State.Running != nil && !status.Ready && status.Name != clientservingv1.QueueProxyContainerName:
+				if probeFailures == nil {
+					var err error
+					if probeFailures, err = d.probeFailures(ctx, diagnosis.Service); err != nil {
+						return err
+					}
+				}
+				message, failed := probeFailures[pod.Name]
+				if !failed {
+					continue
+				}
+				finding.Reason = ReasonReadinessProbe
+				finding.Message = message
+			default:
+				continue
+			}
+			if diagnosis.has(finding.Reason, status.Name) {
+				continue
+			}
+			if finding.Reason == ReasonCrashLoop || finding.Reason == ReasonOOMKilled {
+				finding.Logs = d.lastLogLines(ctx, pod, status.Name)
+			}
+			diagnosis.add(finding)
+		}
+	}
+	return nil
+}
+
+// probeFailures returns the last message of failed probes of each pod of the service,
+// as reported by events
+func (d *Diagnoser) probeFailures(ctx context.Context, service string) (map[string]string, error) {
+	events, err := clientservingv1.NewEventCollector(d.client, d.kube, service).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	failures := map[string]string{}
+	// events are sorted by the time they have been seen last, so the last message wins
+	for _, event := range events {
+		if pod, isPod := strings.CutPrefix(event.Object, "Pod/"); isPod && event.Reason == "Unhealthy" {
+			failures[pod] = event.Message
+		}
+	}
+	return failures, nil
+}
+
+// lastLogLines returns the last log lines of the previous run of the container, or of the
+// current one if it hasn't been restarted. Logs are a best effort, so errors are ignored.
+func (d *Diagnoser) lastLogLines(ctx context.Context, pod *corev1.Pod, container string) []string {
+	if d.LogLines <= 0 {
+		return nil
+	}
+	for _, previous := range []bool{true, false} {
+		tail := d.LogLines
+		stream, err := d.kube.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: container,
+			Previous:  previous,
+			TailLines: &tail,
+		}).Stream(ctx)
+		if err != nil {
+			continue
+		}
+		var lines []string
+		scanner := bufio.NewScanner(stream)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		stream.Close()
+		if len(lines) > 0 {
+			return lines
+		}
+	}
+	return nil
+}
+
+func diagnoseReplicaSet(diagnosis *Diagnosis, replicaSet *appsv1.ReplicaSet) {
+	for _, cond := range replicaSet.Status.Conditions {
+		if cond.Type != appsv1.ReplicaSetReplicaFailure || cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		reason := ReasonPodCreationFailed
+		if strings.Contains(cond.Message, "admission webhook") {
+			reason = ReasonAdmissionRejected
+		}
+		diagnosis.add(Finding{Reason: reason, Object: "ReplicaSet/" + replicaSet.Name, Message: cond.Message})
+	}
+}
+
+func notReady(cond *apis.Condition) *apis.Condition {
+	if cond == nil || cond.IsTrue() {
+		return nil
+	}
+	return cond
+}
+
+func (d *Diagnosis) add(finding Finding) {
+	d.Findings = append(d.Findings, finding)
+}
+
+func (d *Diagnosis) has(reason, container string) bool {
+	for _, finding := range d.Findings {
+		if finding.Reason == reason && finding.Container == container {
+			return true
+		}
+	}
+	return false
+}
+
+// Write prints the findings along with an explanation of each
+func (d *Diagnosis) Write(out io.Writer) {
+	if len(d.Findings) == 0 {
+		if d.Revision == "" {
+			fmt.Fprintf(out, "No cause found for service '%s' not becoming ready.\n", d.Service)
+		} else {
+			fmt.Fprintf(out, "No cause found for revision '%s' not becoming ready.\n", d.Revision)
+		}
+		return
+	}
+	if d.Revision == "" {
+		fmt.Fprintf(out, "Diagnosis of service '%s':\n", d.Service)
+	} else {
+		fmt.Fprintf(out, "Diagnosis of revision '%s' of service '%s':\n", d.Revision, d.Service)
+	}
+	for _, finding := range d.Findings {
+		object := finding.Object
+		if finding.Container != "" {
+			object = fmt.Sprintf("%s (container %s)", object, finding.Container)
+		}
+		fmt.Fprintf(out, "\n  %s: %s\n", finding.Reason, object)
+		fmt.Fprintf(out, "    %s\n", explanations[finding.Reason])
+		if finding.Message != "" {
+			fmt.Fprintf(out, "    Message: %s\n", finding.Message)
+		}
+		if len(finding.Logs) > 0 {
+			fmt.Fprintln(out, "    Last log lines:")
+			for _, line := range finding.Logs {
+				fmt.Fprintf(out, "      %s\n", line)
+			}
+		}
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestDiagnose(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		objects  []runtime.Object
+		findings []Finding
+		// events are collected for the objects owned by the revisions
+		events bool
+	}{
+		{
+			name: "image pull",
+			objects: []runtime.Object{
+				newPod("foo-00002-1", waiting("ImagePullBackOff", "Back-off pulling image \"foo:nope\"")),
+				newPod("foo-00002-2", waiting("ErrImagePull", "manifest unknown")),
+			},
+			findings: []Finding{
+				{Reason: ReasonImagePull, Object: "Pod/foo-00002-1", Container: "user-container", Message: "Back-off pulling image \"foo:nope\""},
+			},
+		},
+		{
+			name: "crash loop",
+			objects: []runtime.Object{
+				newPod("foo-00002-1", func(status *corev1.ContainerStatus) {
+					waiting("CrashLoopBackOff", "back-off restarting failed container")(status)
+					status.RestartCount = 3
+					status.LastTerminationState.Terminated = &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}
+				}),
+			},
+			findings: []Finding{
+				{Reason: ReasonCrashLoop, Object: "Pod/foo-00002-1", Container: "user-container", Message: "Exited with code 1, restarted 3 times", Logs: []string{"fake logs"}},
+			},
+		},
+		{
+			name: "oom killed",
+			objects: []runtime.Object{
+				newPod("foo-00002-1", func(status *corev1.ContainerStatus) {
+					status.State.Running = &corev1.ContainerStateRunning{}
+					status.RestartCount = 1
+					status.LastTerminationState.Terminated = &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}
+				}),
+			},
+			findings: []Finding{
+				{Reason: ReasonOOMKilled, Object: "Pod/foo-00002-1", Container: "user-container", Message: "Killed after running out of memory, restarted 1 times", Logs: []string{"fake logs"}},
+			},
+		},
+		{
+			name: "unschedulable",
+			objects: []runtime.Object{
+				withPodCondition(newPod("foo-00002-1"), corev1.PodScheduled, corev1.PodReasonUnschedulable, "0/3 nodes are available: 3 Insufficient cpu."),
+				withPodCondition(newPod("foo-00002-2"), corev1.PodScheduled, corev1.PodReasonUnschedulable, "0/3 nodes are available: 3 Insufficient cpu."),
+			},
+			findings: []Finding{
+				{Reason: ReasonUnschedulable, Object: "Pod/foo-00002-1", Message: "0/3 nodes are available: 3 Insufficient cpu."},
+			},
+		},
+		{
+			name: "readiness probe",
+			objects: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: ownedMeta("foo-00002-deployment", "foo-00002")},
+				&appsv1.ReplicaSet{ObjectMeta: ownedMeta("foo-00002-deployment-1", "foo-00002-deployment")},
+				withOwner(newPod("foo-00002-1", func(status *corev1.ContainerStatus) {
+					status.State.Running = &corev1.ContainerStateRunning{}
+				}), "foo-00002-deployment-1"),
+				&corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "default"},
+					InvolvedObject: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "foo-00002-1"},
+					Reason:         "Unhealthy",
+					Message:        "Readiness probe failed: connection refused",
+				},
+			},
+			findings: []Finding{
+				{Reason: ReasonReadinessProbe, Object: "Pod/foo-00002-1", Container: "user-container", Message: "Readiness probe failed: connection refused"},
+			},
+			events: true,
+		},
+		{
+			name: "admission webhook",
+			objects: []runtime.Object{
+				&appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo-00002-deployment-1", Namespace: "default", Labels: revisionLabels()},
+					Status: appsv1.ReplicaSetStatus{Conditions: []appsv1.ReplicaSetCondition{{
+						Type: appsv1.ReplicaSetReplicaFailure, Status: corev1.ConditionTrue, Reason: "FailedCreate",
+						Message: "admission webhook \"policy.example.com\" denied the request: privileged",
+					}}},
+				},
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo-00002-deployment", Namespace: "default", Labels: revisionLabels()},
+					Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+						Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded",
+						Message: "ReplicaSet has timed out progressing.",
+					}}},
+				},
+			},
+			findings: []Finding{
+				{Reason: ReasonAdmissionRejected, Object: "ReplicaSet/foo-00002-deployment-1", Message: "admission webhook \"policy.example.com\" denied the request: privileged"},
+				{Reason: ReasonProgressDeadline, Object: "Deployment/foo-00002-deployment", Message: "ReplicaSet has timed out progressing."},
+			},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			client := clientservingv1.NewMockKnServiceClient(t)
+			r := client.Recorder()
+			r.GetService("foo", newService("foo-00002"), nil)
+			r.GetRevision("foo-00002", newRevision(), nil)
+			if c.events {
+				r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{*newRevision()}}, nil)
+			}
+
+			diagnosis, err := NewDiagnoser(client, fake.NewSimpleClientset(c.objects...)).Diagnose(context.Background(), "foo")
+			assert.NilError(t, err)
+			assert.Equal(t, diagnosis.Revision, "foo-00002")
+			expected := append(c.findings, Finding{Reason: ReasonRevisionNotReady, Object: "Revision/foo-00002", Message: "ContainerMissing: Unable to fetch image"})
+			assert.DeepEqual(t, diagnosis.Findings, expected)
+
+			r.Validate()
+		})
+	}
+}
+
+func TestDiagnoseWithoutRevision(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := newService("")
+	service.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Message: "Revision creation failed"}}
+	r.GetService("foo", service, nil)
+	r.GetService("bar", newService(""), nil)
+
+	diagnosis, err := NewDiagnoser(client, fake.NewSimpleClientset()).Diagnose(context.Background(), "foo")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, diagnosis.Findings, []Finding{{Reason: ReasonServiceNotReady, Object: "Service/foo", Message: "Revision creation failed"}})
+
+	diagnosis, err = NewDiagnoser(client, fake.NewSimpleClientset()).Diagnose(context.Background(), "bar")
+	assert.NilError(t, err)
+	out := &bytes.Buffer{}
+	diagnosis.Write(out)
+	assert.Equal(t, out.String(), "No cause found for service 'bar' not becoming ready.\n")
+
+	r.Validate()
+}
+
+func TestDiagnosisWrite(t *testing.T) {
+	diagnosis := &Diagnosis{Service: "foo", Revision: "foo-00002", Findings: []Finding{
+		{Reason: ReasonCrashLoop, Object: "Pod/foo-00002-1", Container: "user-container", Message: "Exited with code 1", Logs: []string{"panic: oops"}},
+		{Reason: ReasonRevisionNotReady, Object: "Revision/foo-00002"},
+	}}
+	out := &bytes.Buffer{}
+	diagnosis.Write(out)
+	assert.Assert(t, util.ContainsAll(out.String(),
+		"Diagnosis of revision 'foo-00002' of service 'foo':",
+		"CrashLoop: Pod/foo-00002-1 (container user-container)",
+		explanations[ReasonCrashLoop],
+		"Message: Exited with code 1",
+		"Last log lines:\n      panic: oops",
+		"RevisionNotReady: Revision/foo-00002"))
+}
+
+func newService(latestCreated string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	service.Status.LatestCreatedRevisionName = latestCreated
+	return service
+}
+
+func newRevision() *servingv1.Revision {
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: "foo-00002", Namespace: "default", UID: "foo-00002"}}
+	revision.Status.Conditions = []apis.Condition{{
+		Type: apis.ConditionReady, Status: corev1.ConditionFalse, Reason: "ContainerMissing", Message: "Unable to fetch image",
+	}}
+	return revision
+}
+
+func revisionLabels() map[string]string {
+	return map[string]string{serving.RevisionLabelKey: "foo-00002", serving.ServiceLabelKey: "foo"}
+}
+
+// ownedMeta returns the metadata of an object of the revision owned by the given object,
+// whose UID is its name
+func ownedMeta(name, owner string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       "default",
+		UID:             types.UID(name),
+		Labels:          revisionLabels(),
+		OwnerReferences: []metav1.OwnerReference{{Name: owner, UID: types.UID(owner)}},
+	}
+}
+
+func withOwner(pod *corev1.Pod, owner string) *corev1.Pod {
+	pod.ObjectMeta = ownedMeta(pod.Name, owner)
+	return pod
+}
+
+func newPod(name string, statusFns ...func(*corev1.ContainerStatus)) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: revisionLabels()},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "user-container"}, {Name: clientservingv1.QueueProxyContainerName}},
+		},
+	}
+	status := corev1.ContainerStatus{Name: "user-container"}
+	for _, fn := range statusFns {
+		fn(&status)
+	}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		status,
+		{Name: clientservingv1.QueueProxyContainerName, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+	}
+	return pod
+}
+
+func waiting(reason, message string) func(*corev1.ContainerStatus) {
+	return func(status *corev1.ContainerStatus) {
+		status.State.Waiting = &corev1.ContainerStateWaiting{Reason: reason, Message: message}
+	}
+}
+
+func withPodCondition(pod *corev1.Pod, condition corev1.PodConditionType, reason, message string) *corev1.Pod {
+	pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{
+		Type: condition, Status: corev1.ConditionFalse, Reason: reason, Message: message,
+	})
+	return pod
+}
@@ -23,9 +23,12 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
 	"knative.dev/eventing/pkg/apis/messaging"
 )
 
@@ -63,6 +66,12 @@ type KnDynamicClient interface {
 	// ListSourcesUsingGVKs returns list of available source objects using given list of GVKs
 	ListSourcesUsingGVKs(context.Context, *[]schema.GroupVersionKind, ...WithType) (*unstructured.UnstructuredList, error)
 
+	// WatchSources watches the source objects of all available source types
+	WatchSources(ctx context.Context, types ...WithType) (watch.Interface, error)
+
+	// WatchSourcesUsingGVKs watches the source objects of the given list of GVKs
+	WatchSourcesUsingGVKs(context.Context, *[]schema.GroupVersionKind, ...WithType) (watch.Interface, error)
+
 	// ListChannelsTypes returns installed knative channel CRDs
 	ListChannelsTypes(ctx context.Context) (*unstructured.UnstructuredList, error)
 
@@ -153,6 +162,51 @@ func (c *knDynamicClient) ListSources(ctx context.Context, types ...WithType) (*
 		sourceList unstructured.UnstructuredList
 		options    metav1.ListOptions
 	)
+	gvrs, err := c.sourceGVRs(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := c.Namespace()
+	// For each source type available, find out each source types objects
+	for _, gvr := range gvrs {
+		// list objects of source type with this GVR
+		sList, err := c.client.Resource(gvr).Namespace(namespace).List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sList.Items) > 0 {
+			sourceList.Items = append(sourceList.Items, sList.Items...)
+		}
+	}
+	if len(sourceList.Items) > 0 {
+		sourceList.SetGroupVersionKind(schema.GroupVersionKind{Group: sourceListGroup, Version: sourceListVersion, Kind: sourceListKind})
+	}
+	return &sourceList, nil
+}
+
+// WatchSources watches the source objects of all available source types, or of the
+// given source types only. As the source types differ in their resource versions, all
+// existing source objects are reported as added first.
+func (c *knDynamicClient) WatchSources(ctx context.Context, types ...WithType) (watch.Interface, error) {
+	gvrs, err := c.sourceGVRs(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+	return c.watchResources(ctx, gvrs)
+}
+
+// WatchSourcesUsingGVKs watches the source objects of the given GVKs
+func (c *knDynamicClient) WatchSourcesUsingGVKs(ctx context.Context, gvks *[]schema.GroupVersionKind, types ...WithType) (watch.Interface, error) {
+	if gvks == nil {
+		return nil, errors.New("no source types given to watch")
+	}
+	return c.watchResources(ctx, gvrsFromGVKs(*gvks, types))
+}
+
+// sourceGVRs returns the GVRs of the installed source types, filtered by the given types
+func (c *knDynamicClient) sourceGVRs(ctx context.Context, types []WithType) ([]schema.GroupVersionResource, error) {
 	sourceTypes, err := c.ListSourcesTypes(ctx)
 	if err != nil {
 		return nil, err
@@ -162,9 +216,8 @@ func (c *knDynamicClient) ListSources(ctx context.Context, types ...WithType) (*
 		return nil, errors.New("no sources found on the backend, please verify the installation")
 	}
 
-	namespace := c.Namespace()
+	var gvrs []schema.GroupVersionResource
 	filters := WithTypes(types).List()
-	// For each source type available, find out each source types objects
 	for i := range sourceTypes.Items {
 		source := &sourceTypes.Items[i]
 		// find source kind before hand to fail early
@@ -182,21 +235,28 @@ func (c *knDynamicClient) ListSources(ctx context.Context, types ...WithType) (*
 		if err != nil {
 			return nil, err
 		}
+		gvrs = append(gvrs, gvr)
+	}
+	return gvrs, nil
+}
 
-		// list objects of source type with this GVR
-		sList, err := c.client.Resource(gvr).Namespace(namespace).List(ctx, options)
+// watchResources watches all objects of the given GVRs in the client's namespace
+func (c *knDynamicClient) watchResources(ctx context.Context, gvrs []schema.GroupVersionResource) (watch.Interface, error) {
+	watchers := make([]watch.Interface, 0, len(gvrs))
+	for _, gvr := range gvrs {
+		resource := c.client.Resource(gvr).Namespace(c.Namespace())
+		watcher, err := wait.NewListWatcherWithVersion(ctx, resource.Watch, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return resource.List(ctx, opts)
+		}, metav1.ListOptions{})
 		if err != nil {
+			for _, w := range watchers {
+				w.Stop()
+			}
 			return nil, err
 		}
-
-		if len(sList.Items) > 0 {
-			sourceList.Items = append(sourceList.Items, sList.Items...)
-		}
-	}
-	if len(sourceList.Items) > 0 {
-		sourceList.SetGroupVersionKind(schema.GroupVersionKind{Group: sourceListGroup, Version: sourceListVersion, Kind: sourceListKind})
+		watchers = append(watchers, watcher)
 	}
-	return &sourceList, nil
+	return wait.MergeWatchers(watchers...), nil
 }
 
 // ListSourcesUsingGVKs returns list of available source objects using given list of GVKs
@@ -210,15 +270,8 @@ func (c *knDynamicClient) ListSourcesUsingGVKs(ctx context.Context, gvks *[]sche
 		options    metav1.ListOptions
 	)
 	namespace := c.Namespace()
-	filters := WithTypes(types).List()
-
-	for _, gvk := range *gvks {
-		if len(filters) > 0 && !util.SliceContainsIgnoreCase(filters, gvk.Kind) {
-			continue
-		}
-
-		gvr := gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind) + "s")
 
+	for _, gvr := range gvrsFromGVKs(*gvks, types) {
 		// list objects of source type with this GVR
 		sList, err := c.client.Resource(gvr).Namespace(namespace).List(ctx, options)
 		if err != nil {
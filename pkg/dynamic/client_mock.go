@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"knative.dev/client/pkg/util/mock"
 )
@@ -127,6 +128,30 @@ func (c *MockKnDynamicClient) ListSourcesUsingGVKs(ctx context.Context, gvks *[]
 	return call.Result[0].(*unstructured.UnstructuredList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchSources records a call to WatchSources with the expected watcher and error
+func (dr *ClientRecorder) WatchSources(types interface{}, watcher watch.Interface, err error) {
+	dr.r.Add("WatchSources", []interface{}{types}, []interface{}{watcher, err})
+}
+
+// WatchSources watches the source objects of all available source types
+func (c *MockKnDynamicClient) WatchSources(ctx context.Context, types ...WithType) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchSources", types)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
+// WatchSourcesUsingGVKs records a call to WatchSourcesUsingGVKs with the expected watcher and error
+func (dr *ClientRecorder) WatchSourcesUsingGVKs(gvks interface{}, types interface{}, watcher watch.Interface, err error) {
+	dr.r.Add("WatchSourcesUsingGVKs", []interface{}{gvks, types}, []interface{}{watcher, err})
+}
+
+// WatchSourcesUsingGVKs watches the source objects of the given GVKs
+func (c *MockKnDynamicClient) WatchSourcesUsingGVKs(ctx context.Context, gvks *[]schema.GroupVersionKind, types ...WithType) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchSourcesUsingGVKs", gvks, types)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Validate validates whether every recorded action has been called
 func (dr *ClientRecorder) Validate() {
 	dr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
@@ -37,6 +37,8 @@ func TestMockKnDynamicClient(t *testing.T) {
 	recorder.RawClient(&fake.FakeDynamicClient{})
 	recorder.ListSourcesUsingGVKs(mock.Any(), mock.Any(), nil, nil)
 	recorder.ListChannelsUsingGVKs(mock.Any(), mock.Any(), nil, nil)
+	recorder.WatchSources(mock.Any(), nil, nil)
+	recorder.WatchSourcesUsingGVKs(mock.Any(), mock.Any(), nil, nil)
 
 	ctx := context.Background()
 	client.ListCRDs(ctx, metav1.ListOptions{})
@@ -46,6 +48,8 @@ func TestMockKnDynamicClient(t *testing.T) {
 	client.RawClient()
 	client.ListSourcesUsingGVKs(ctx, &[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
 	client.ListChannelsUsingGVKs(ctx, &[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
+	client.WatchSources(ctx, WithTypeFilter("blub"))
+	client.WatchSourcesUsingGVKs(ctx, &[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
 
 	// Validate
 	recorder.Validate()
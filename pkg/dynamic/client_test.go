@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/messaging"
@@ -175,6 +176,29 @@ func TestListSourcesUsingGVKs(t *testing.T) {
 
 }
 
+func TestWatchSources(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1", "PingSource"),
+		newSourceCRDObjWithSpec("apiserversources", "sources.knative.dev", "v1", "ApiServerSource"),
+		newSourceUnstructuredObj("p1", "sources.knative.dev/v1", "PingSource"),
+		newSourceUnstructuredObj("a1", "sources.knative.dev/v1", "ApiServerSource"),
+	)
+	watcher, err := client.WatchSources(context.Background(), WithTypeFilter("pingsource"))
+	assert.NilError(t, err)
+	defer watcher.Stop()
+
+	created := newSourceUnstructuredObj("p2", "sources.knative.dev/v1", "PingSource")
+	gvr := schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1", Resource: "pingsources"}
+	_, err = client.RawClient().Resource(gvr).Namespace(testNamespace).Create(context.Background(), created, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	event := <-watcher.ResultChan()
+	assert.Equal(t, event.Type, watch.Added)
+	assert.Equal(t, event.Object.(*unstructured.Unstructured).GetName(), "p2")
+
+	_, err = client.WatchSourcesUsingGVKs(context.Background(), nil)
+	assert.ErrorContains(t, err, "no source types")
+}
+
 // createFakeKnDynamicClient gives you a dynamic client for testing containing the given objects.
 // See also the one in the fake package. Duplicated here to avoid a dependency loop.
 func createFakeKnDynamicClient(testNamespace string, objects ...runtime.Object) KnDynamicClient {
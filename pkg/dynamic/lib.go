@@ -20,6 +20,8 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/client/pkg/util"
 )
 
 // gvrFromUnstructured takes a unstructured object of CRD type and finds GVR from its spec
@@ -146,3 +148,16 @@ func UnstructuredCRDFromGVK(gvk schema.GroupVersionKind) *unstructured.Unstructu
 
 	return u
 }
+
+// gvrsFromGVKs returns the GVRs of the given GVKs, filtered by the given types
+func gvrsFromGVKs(gvks []schema.GroupVersionKind, types []WithType) []schema.GroupVersionResource {
+	filters := WithTypes(types).List()
+	var gvrs []schema.GroupVersionResource
+	for _, gvk := range gvks {
+		if len(filters) > 0 && !util.SliceContainsIgnoreCase(filters, gvk.Kind) {
+			continue
+		}
+		gvrs = append(gvrs, gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind)+"s"))
+	}
+	return gvrs
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import "errors"
+
+// ExitCodeError signals that kn should terminate with a specific exit code.
+// It is used by commands which report a result via the exit code (like
+// a detected difference), so the message can be empty
+type ExitCodeError struct {
+	code int
+	msg  string
+	err  error
+}
+
+// NewExitCodeError creates an error which makes kn exit with the given code.
+// If msg is empty, no error message is printed.
+func NewExitCodeError(code int, msg string) *ExitCodeError {
+	return &ExitCodeError{
+		code: code,
+		msg:  msg,
+	}
+}
+
+// WithExitCode wraps the error so that kn terminates with the given exit code
+// instead of the default one
+func WithExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ExitCodeError{
+		code: code,
+		msg:  err.Error(),
+		err:  err,
+	}
+}
+
+// WithDefaultExitCode wraps the error so that kn terminates with the given exit code,
+// unless the error already comes with an exit code. It is used by commands which
+// report a result via the exit code, to tell a failure apart from that result.
+func WithDefaultExitCode(code int, err error) error {
+	var exitCodeError *ExitCodeError
+	if err == nil || errors.As(err, &exitCodeError) {
+		return err
+	}
+	return WithExitCode(code, err)
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.msg
+}
+
+// ExitCode returns the exit code kn should terminate with
+func (e *ExitCodeError) ExitCode() int {
+	return e.code
+}
+
+// Unwrap returns the wrapped error, if any
+func (e *ExitCodeError) Unwrap() error {
+	return e.err
+}
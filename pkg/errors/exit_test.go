@@ -0,0 +1,51 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestExitCodeError(t *testing.T) {
+	err := NewExitCodeError(2, "boom")
+	assert.Equal(t, err.Error(), "boom")
+	assert.Equal(t, err.ExitCode(), 2)
+}
+
+func TestWithExitCode(t *testing.T) {
+	assert.NilError(t, WithExitCode(2, nil))
+	cause := errors.New("boom")
+	err := WithExitCode(2, cause)
+	assert.Equal(t, err.Error(), "boom")
+	assert.Assert(t, errors.Is(err, cause))
+	var exitCodeError *ExitCodeError
+	assert.Assert(t, errors.As(err, &exitCodeError))
+	assert.Equal(t, exitCodeError.ExitCode(), 2)
+}
+
+func TestWithDefaultExitCode(t *testing.T) {
+	assert.NilError(t, WithDefaultExitCode(2, nil))
+	cause := errors.New("boom")
+	var exitCodeError *ExitCodeError
+	assert.Assert(t, errors.As(WithDefaultExitCode(2, cause), &exitCodeError))
+	assert.Equal(t, exitCodeError.ExitCode(), 2)
+
+	err := WithDefaultExitCode(2, NewExitCodeError(1, ""))
+	assert.Assert(t, errors.As(err, &exitCodeError))
+	assert.Equal(t, exitCodeError.ExitCode(), 1)
+}
@@ -52,6 +52,8 @@ type KnEventingClient interface {
 	GetTrigger(ctx context.Context, name string) (*eventingv1.Trigger, error)
 	// ListTriggers returns list of trigger CRDs
 	ListTriggers(ctx context.Context) (*eventingv1.TriggerList, error)
+	// WatchTriggers watches triggers for changes after the given resource version
+	WatchTriggers(ctx context.Context, resourceVersion string) (watch.Interface, error)
 	// UpdateTrigger is used to update an instance of trigger
 	UpdateTrigger(ctx context.Context, trigger *eventingv1.Trigger) error
 	// UpdateTriggerWithRetry is used to update an instance of trigger
@@ -64,6 +66,8 @@ type KnEventingClient interface {
 	DeleteBroker(ctx context.Context, name string, timeout time.Duration) error
 	// ListBrokers returns list of broker CRDs
 	ListBrokers(ctx context.Context) (*eventingv1.BrokerList, error)
+	// WatchBrokers watches brokers for changes after the given resource version
+	WatchBrokers(ctx context.Context, resourceVersion string) (watch.Interface, error)
 	// UpdateBroker is used to update an instance of broker
 	UpdateBroker(ctx context.Context, broker *eventingv1.Broker) error
 	// UpdateBrokerWithRetry is used to update an instance of broker
@@ -140,6 +144,15 @@ func (c *knEventingClient) ListTriggers(ctx context.Context) (*eventingv1.Trigge
 	return triggerListNew, nil
 }
 
+// WatchTriggers is used to watch all triggers, falling back to polling if the server
+// doesn't support watching
+func (c *knEventingClient) WatchTriggers(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	triggers := c.client.Triggers(c.namespace)
+	return watchEventingList(ctx, triggers.Watch, func(ctx context.Context, opts apis_v1.ListOptions) (runtime.Object, error) {
+		return triggers.List(ctx, opts)
+	}, resourceVersion)
+}
+
 // UpdateTrigger is used to update an instance of trigger
 func (c *knEventingClient) UpdateTrigger(ctx context.Context, trigger *eventingv1.Trigger) error {
 	_, err := c.client.Triggers(c.namespace).Update(ctx, trigger, meta_v1.UpdateOptions{})
@@ -262,6 +275,17 @@ func (b *TriggerBuilder) Filters(filters map[string]string) *TriggerBuilder {
 	return b
 }
 
+// SubscriptionsAPIFilters sets the filter expressions of spec.filters, which override the
+// attributes of spec.filter
+func (b *TriggerBuilder) SubscriptionsAPIFilters(filters []eventingv1.SubscriptionsAPIFilter) *TriggerBuilder {
+	if len(filters) == 0 {
+		b.trigger.Spec.Filters = nil
+		return b
+	}
+	b.trigger.Spec.Filters = filters
+	return b
+}
+
 // Build to return an instance of trigger object
 func (b *TriggerBuilder) Build() *eventingv1.Trigger {
 	return b.trigger
@@ -294,6 +318,32 @@ func (c *knEventingClient) WatchBroker(ctx context.Context, name string, initial
 	return wait.NewWatcherWithVersion(ctx, c.client.Brokers(c.namespace).Watch, c.client.RESTClient(), c.namespace, "brokers", name, initialVersion, timeout)
 }
 
+// WatchBrokers is used to watch all brokers
+func (c *knEventingClient) WatchBrokers(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	brokers := c.client.Brokers(c.namespace)
+	return watchEventingList(ctx, brokers.Watch, func(ctx context.Context, opts apis_v1.ListOptions) (runtime.Object, error) {
+		return brokers.List(ctx, opts)
+	}, resourceVersion)
+}
+
+// watchEventingList watches all resources of a kind and updates the watched objects
+// with the eventing GroupVersionKind
+func watchEventingList(ctx context.Context,
+	watchFunc func(context.Context, apis_v1.ListOptions) (watch.Interface, error),
+	listFunc func(context.Context, apis_v1.ListOptions) (runtime.Object, error),
+	resourceVersion string) (watch.Interface, error) {
+	watcher, err := wait.NewListWatcherWithVersion(ctx, watchFunc, listFunc, apis_v1.ListOptions{ResourceVersion: resourceVersion})
+	if err != nil {
+		return nil, kn_errors.GetError(err)
+	}
+	return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+		if event.Type != watch.Error && event.Object != nil {
+			_ = updateEventingGVK(event.Object)
+		}
+		return event, true
+	}), nil
+}
+
 // DeleteBroker is used to delete an instance of broker and wait for completion until given timeout
 // For `timeout == 0` delete is performed async without any wait
 func (c *knEventingClient) DeleteBroker(ctx context.Context, name string, timeout time.Duration) error {
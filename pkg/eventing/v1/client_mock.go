@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/watch"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 
 	"knative.dev/client/pkg/util/mock"
@@ -104,6 +105,18 @@ func (c *MockKnEventingClient) ListTriggers(context.Context) (*eventingv1.Trigge
 	return call.Result[0].(*eventingv1.TriggerList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchTriggers records a call for WatchTriggers with the expected watcher and error (nil if none)
+func (sr *EventingRecorder) WatchTriggers(resourceVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchTriggers", []interface{}{resourceVersion}, []interface{}{watcher, err})
+}
+
+// WatchTriggers performs a previously recorded action
+func (c *MockKnEventingClient) WatchTriggers(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchTriggers", resourceVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // UpdateTrigger records a call for UpdateTrigger with the expected result and error (nil if none)
 func (sr *EventingRecorder) UpdateTrigger(trigger interface{}, err error) {
 	sr.r.Add("UpdateTrigger", []interface{}{trigger}, []interface{}{err})
@@ -163,6 +176,18 @@ func (c *MockKnEventingClient) ListBrokers(context.Context) (*eventingv1.BrokerL
 	return call.Result[0].(*eventingv1.BrokerList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchBrokers records a call for WatchBrokers with the expected watcher and error (nil if none)
+func (sr *EventingRecorder) WatchBrokers(resourceVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchBrokers", []interface{}{resourceVersion}, []interface{}{watcher, err})
+}
+
+// WatchBrokers performs a previously recorded action
+func (c *MockKnEventingClient) WatchBrokers(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchBrokers", resourceVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // UpdateBroker records a call for UpdateBroker with the expected result and error (nil if none)
 func (sr *EventingRecorder) UpdateBroker(broker *eventingv1.Broker, err error) {
 	sr.r.Add("UpdateBroker", []interface{}{broker}, []interface{}{err})
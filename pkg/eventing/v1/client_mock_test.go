@@ -33,6 +33,7 @@ func TestMockKnClient(t *testing.T) {
 	recorder.CreateTrigger(&eventingv1.Trigger{}, nil)
 	recorder.DeleteTrigger("hello", nil)
 	recorder.ListTriggers(nil, nil)
+	recorder.WatchTriggers("1", nil, nil)
 	recorder.UpdateTrigger(&eventingv1.Trigger{}, nil)
 	recorder.GetTrigger("hello", &eventingv1.Trigger{}, nil)
 	recorder.UpdateTrigger(&eventingv1.Trigger{}, nil)
@@ -41,6 +42,7 @@ func TestMockKnClient(t *testing.T) {
 	recorder.GetBroker("foo", nil, nil)
 	recorder.DeleteBroker("foo", time.Duration(10)*time.Second, nil)
 	recorder.ListBrokers(nil, nil)
+	recorder.WatchBrokers("1", nil, nil)
 	recorder.GetBroker("foo", &eventingv1.Broker{}, nil)
 	recorder.UpdateBroker(&eventingv1.Broker{}, nil)
 	recorder.UpdateBroker(&eventingv1.Broker{}, nil)
@@ -51,6 +53,7 @@ func TestMockKnClient(t *testing.T) {
 	client.CreateTrigger(ctx, &eventingv1.Trigger{})
 	client.DeleteTrigger(ctx, "hello")
 	client.ListTriggers(ctx)
+	client.WatchTriggers(ctx, "1")
 	client.UpdateTrigger(ctx, &eventingv1.Trigger{})
 	client.UpdateTriggerWithRetry(ctx, "hello", func(origTrigger *eventingv1.Trigger) (*eventingv1.Trigger, error) {
 		return origTrigger, nil
@@ -60,6 +63,7 @@ func TestMockKnClient(t *testing.T) {
 	client.GetBroker(ctx, "foo")
 	client.DeleteBroker(ctx, "foo", time.Duration(10)*time.Second)
 	client.ListBrokers(ctx)
+	client.WatchBrokers(ctx, "1")
 	client.UpdateBroker(ctx, &eventingv1.Broker{})
 	client.UpdateBrokerWithRetry(ctx, "foo", func(origBroker *eventingv1.Broker) (*eventingv1.Broker, error) {
 		return origBroker, nil
@@ -149,6 +149,29 @@ func TestListTrigger(t *testing.T) {
 	})
 }
 
+func TestWatchTriggers(t *testing.T) {
+	serving, client := setup()
+
+	// without watch support on the server, the triggers are polled
+	serving.AddWatchReactor("triggers",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			return true, nil, errors.NewMethodNotSupported(eventingv1.Resource("triggers"), "watch")
+		})
+	serving.AddReactor("list", "triggers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			return true, &eventingv1.TriggerList{Items: []eventingv1.Trigger{*newTrigger("trigger-1")}}, nil
+		})
+
+	watcher, err := client.WatchTriggers(context.Background(), "1")
+	assert.NilError(t, err)
+	defer watcher.Stop()
+	event := <-watcher.ResultChan()
+	assert.Equal(t, event.Type, watch.Added)
+	assert.Equal(t, event.Object.(*eventingv1.Trigger).Name, "trigger-1")
+	assert.Equal(t, event.Object.GetObjectKind().GroupVersionKind().Kind, "Trigger")
+}
+
 func TestUpdateTrigger(t *testing.T) {
 	serving, client := setup()
 	t.Run("update trigger will update the trigger",
@@ -307,6 +330,19 @@ func TestTriggerBuilder(t *testing.T) {
 		assert.DeepEqual(t, expected, b.Build().Spec.Filter)
 	})
 
+	t.Run("update subscriptions API filters", func(t *testing.T) {
+		b := NewTriggerBuilderFromExisting(a.Build())
+		filters := []eventingv1.SubscriptionsAPIFilter{
+			{Prefix: map[string]string{"type": "dev.knative."}},
+			{CESQL: "source LIKE '%.example.com'"},
+		}
+		b.SubscriptionsAPIFilters(filters)
+		assert.DeepEqual(t, filters, b.Build().Spec.Filters)
+
+		b.SubscriptionsAPIFilters([]eventingv1.SubscriptionsAPIFilter{})
+		assert.Assert(t, b.Build().Spec.Filters == nil)
+	})
+
 	t.Run("add and remove inject annotation", func(t *testing.T) {
 		b := NewTriggerBuilder("broker-trigger")
 		b.InjectBroker(true)
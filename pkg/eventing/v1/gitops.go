@@ -0,0 +1,135 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/watch"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	"knative.dev/client/pkg/gitops"
+)
+
+const (
+	triggerKind = "trigger"
+	brokerKind  = "broker"
+)
+
+// knEventingGitOpsClient works on triggers and brokers
+// stored in a local directory instead of a remote cluster
+type knEventingGitOpsClient struct {
+	namespace string
+	triggers  *gitops.Store[eventingv1.Trigger, *eventingv1.Trigger]
+	brokers   *gitops.Store[eventingv1.Broker, *eventingv1.Broker]
+}
+
+// NewKnEventingGitOpsClient returns an eventing client which works on the local path
+// given as dir, which is either a directory or a single file
+func NewKnEventingGitOpsClient(namespace, dir string) KnEventingClient {
+	return &knEventingGitOpsClient{
+		namespace: namespace,
+		triggers:  gitops.NewStore[eventingv1.Trigger](namespace, dir, triggerKind, eventingv1.Resource("triggers"), updateEventingGVK),
+		brokers:   gitops.NewStore[eventingv1.Broker](namespace, dir, brokerKind, eventingv1.Resource("brokers"), updateEventingGVK),
+	}
+}
+
+// Namespace returns the namespace
+func (c *knEventingGitOpsClient) Namespace() string {
+	return c.namespace
+}
+
+// CreateTrigger saves the trigger in the local path
+func (c *knEventingGitOpsClient) CreateTrigger(ctx context.Context, trigger *eventingv1.Trigger) error {
+	return c.triggers.Create(trigger)
+}
+
+// DeleteTrigger removes the trigger from the local path
+func (c *knEventingGitOpsClient) DeleteTrigger(ctx context.Context, name string) error {
+	return c.triggers.Delete(name)
+}
+
+// GetTrigger reads the trigger from the local path
+func (c *knEventingGitOpsClient) GetTrigger(ctx context.Context, name string) (*eventingv1.Trigger, error) {
+	return c.triggers.Get(name)
+}
+
+// ListTriggers lists the triggers in the local path
+func (c *knEventingGitOpsClient) ListTriggers(ctx context.Context) (*eventingv1.TriggerList, error) {
+	triggers, err := c.triggers.List()
+	if err != nil {
+		return nil, err
+	}
+	triggerList := &eventingv1.TriggerList{Items: triggers}
+	return triggerList, updateEventingGVK(triggerList)
+}
+
+// WatchTriggers isn't supported for triggers stored in files
+func (c *knEventingGitOpsClient) WatchTriggers(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	return nil, errors.New("watching triggers is not supported for triggers stored in files, use a cluster instead")
+}
+
+// UpdateTrigger overwrites the trigger in the local path
+func (c *knEventingGitOpsClient) UpdateTrigger(ctx context.Context, trigger *eventingv1.Trigger) error {
+	return c.triggers.Update(trigger)
+}
+
+// UpdateTriggerWithRetry updates the trigger in the local path
+func (c *knEventingGitOpsClient) UpdateTriggerWithRetry(ctx context.Context, name string, updateFunc TriggerUpdateFunc, nrRetries int) error {
+	return updateTriggerWithRetry(ctx, c, name, updateFunc, nrRetries)
+}
+
+// CreateBroker saves the broker in the local path
+func (c *knEventingGitOpsClient) CreateBroker(ctx context.Context, broker *eventingv1.Broker) error {
+	return c.brokers.Create(broker)
+}
+
+// GetBroker reads the broker from the local path
+func (c *knEventingGitOpsClient) GetBroker(ctx context.Context, name string) (*eventingv1.Broker, error) {
+	return c.brokers.Get(name)
+}
+
+// DeleteBroker removes the broker from the local path. There is nothing to wait for,
+// so the timeout is ignored.
+func (c *knEventingGitOpsClient) DeleteBroker(ctx context.Context, name string, timeout time.Duration) error {
+	return c.brokers.Delete(name)
+}
+
+// ListBrokers lists the brokers in the local path
+func (c *knEventingGitOpsClient) ListBrokers(ctx context.Context) (*eventingv1.BrokerList, error) {
+	brokers, err := c.brokers.List()
+	if err != nil {
+		return nil, err
+	}
+	brokerList := &eventingv1.BrokerList{Items: brokers}
+	return brokerList, updateEventingGVK(brokerList)
+}
+
+// WatchBrokers isn't supported for brokers stored in files
+func (c *knEventingGitOpsClient) WatchBrokers(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	return nil, errors.New("watching brokers is not supported for brokers stored in files, use a cluster instead")
+}
+
+// UpdateBroker overwrites the broker in the local path
+func (c *knEventingGitOpsClient) UpdateBroker(ctx context.Context, broker *eventingv1.Broker) error {
+	return c.brokers.Update(broker)
+}
+
+// UpdateBrokerWithRetry updates the broker in the local path
+func (c *knEventingGitOpsClient) UpdateBrokerWithRetry(ctx context.Context, name string, updateFunc BrokerUpdateFunc, nrRetries int) error {
+	return updateBrokerWithRetry(ctx, c, name, updateFunc, nrRetries)
+}
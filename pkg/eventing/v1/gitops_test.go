@@ -0,0 +1,65 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+func TestEventingGitOpsClient(t *testing.T) {
+	dir := t.TempDir()
+	client := NewKnEventingGitOpsClient("foo-ns", dir)
+	ctx := context.Background()
+
+	t.Run("triggers", func(t *testing.T) {
+		assert.NilError(t, client.CreateTrigger(ctx, newTrigger("t1")))
+		assert.NilError(t, client.UpdateTriggerWithRetry(ctx, "t1", func(trigger *eventingv1.Trigger) (*eventingv1.Trigger, error) {
+			trigger.Spec.Broker = "other"
+			return trigger, nil
+		}, 1))
+		trigger, err := client.GetTrigger(ctx, "t1")
+		assert.NilError(t, err)
+		assert.Equal(t, trigger.Spec.Broker, "other")
+
+		triggers, err := client.ListTriggers(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(triggers.Items), 1)
+		assert.Equal(t, triggers.Kind, "TriggerList")
+
+		assert.NilError(t, client.DeleteTrigger(ctx, "t1"))
+		_, err = client.GetTrigger(ctx, "t1")
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+	})
+	t.Run("brokers", func(t *testing.T) {
+		assert.NilError(t, client.CreateBroker(ctx, newBroker("b1")))
+		broker, err := client.GetBroker(ctx, "b1")
+		assert.NilError(t, err)
+		assert.Equal(t, broker.Kind, "Broker")
+
+		brokers, err := client.ListBrokers(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(brokers.Items), 1)
+
+		assert.NilError(t, client.DeleteBroker(ctx, "b1", time.Second))
+		err = client.DeleteBroker(ctx, "b1", time.Second)
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+	})
+}
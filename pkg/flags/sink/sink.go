@@ -93,6 +93,15 @@ var defaultMappingAliasses = map[string]string{
 
 const knativeServiceShorthand = "ksvc"
 
+// defaultKinds are the kinds of the resources of the DefaultMappings, which are
+// needed for resolving a reference without looking it up in the cluster
+var defaultKinds = map[schema.GroupVersionResource]string{
+	DefaultMappings["kservice"]: "Service",
+	DefaultMappings["broker"]:   "Broker",
+	DefaultMappings["channel"]:  "Channel",
+	DefaultMappings["service"]:  "Service",
+}
+
 // Type returns the type of the reference.
 func (r *Reference) Type() Type {
 	if r.KubeReference != nil {
@@ -105,7 +114,9 @@ func (r *Reference) Type() Type {
 }
 
 // Resolve returns the Destination referred to by the sink. It validates that
-// any object the user is referring to exists.
+// any object the user is referring to exists. If knclient is nil (like when
+// working on local files instead of a cluster), the reference is resolved
+// without validation, which is only possible for the default mappings.
 func (r *Reference) Resolve(ctx context.Context, knclient clientdynamic.KnDynamicClient) (*duckv1.Destination, error) {
 	if r.Type() == TypeURL {
 		return &duckv1.Destination{URI: r.URL}, nil
@@ -114,6 +125,9 @@ func (r *Reference) Resolve(ctx context.Context, knclient clientdynamic.KnDynami
 		return nil, fmt.Errorf("%w: unexpected type %q",
 			ErrSinkIsInvalid, r.Type())
 	}
+	if knclient == nil {
+		return r.resolveWithoutCluster()
+	}
 	client := knclient.RawClient()
 	obj, err := client.Resource(r.GVR).
 		Namespace(r.Namespace).
@@ -133,6 +147,22 @@ func (r *Reference) Resolve(ctx context.Context, knclient clientdynamic.KnDynami
 	return destination, nil
 }
 
+func (r *Reference) resolveWithoutCluster() (*duckv1.Destination, error) {
+	kind, ok := defaultKinds[r.GVR]
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot resolve a reference to %q without a cluster",
+			ErrSinkIsInvalid, r.GVR.GroupResource())
+	}
+	return &duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Kind:       kind,
+			APIVersion: r.GVR.GroupVersion().String(),
+			Name:       r.Name,
+			Namespace:  r.Namespace,
+		},
+	}, nil
+}
+
 // String creates a text representation of the reference
 // Deprecated: use AsText instead
 func (r *Reference) String() string {
@@ -0,0 +1,194 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// documentFile is a YAML file holding any number of documents separated by '---'.
+// Documents are kept as raw text, so that documents which are not touched
+// (including their comments and formatting) are written back unchanged.
+type documentFile struct {
+	path string
+	// docs are the documents, each including its trailing newline
+	docs []string
+	// separators[i] is the separator line between docs[i] and docs[i+1]
+	separators []string
+	exists     bool
+}
+
+// readDocumentFile reads and splits the given file. A file which doesn't exist
+// is returned as an empty document file.
+func readDocumentFile(path string) (*documentFile, error) {
+	f := &documentFile{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return f, nil
+		}
+		return nil, err
+	}
+	f.exists = true
+	f.split(string(data))
+	return f, nil
+}
+
+func (f *documentFile) split(data string) {
+	var doc strings.Builder
+	for _, line := range strings.SplitAfter(data, "\n") {
+		if isDocumentSeparator(line) {
+			f.docs = append(f.docs, doc.String())
+			f.separators = append(f.separators, line)
+			doc.Reset()
+			continue
+		}
+		doc.WriteString(line)
+	}
+	f.docs = append(f.docs, doc.String())
+}
+
+func isDocumentSeparator(line string) bool {
+	line = strings.TrimRight(line, "\r\n")
+	return line == "---" || strings.HasPrefix(line, "--- ") || strings.HasPrefix(line, "---\t")
+}
+
+// find returns the index of the document holding the resource with the given
+// group, kind and name, or -1 if there is no such document. If namespace is not
+// empty, documents with a different namespace are skipped.
+func (f *documentFile) find(kind schema.GroupKind, name, namespace string) (int, error) {
+	for i := range f.docs {
+		meta, err := f.meta(i)
+		if err != nil {
+			return -1, err
+		}
+		if meta != nil && isKind(meta, kind) && meta.Name == name && namespaceMatches(meta.Namespace, namespace) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// isKind returns whether the document holds a resource of the given group and kind,
+// which tells apart e.g. a Knative service from a Kubernetes service
+func isKind(meta *metav1.PartialObjectMetadata, kind schema.GroupKind) bool {
+	return meta.GroupVersionKind().GroupKind() == kind
+}
+
+// meta returns the type and object metadata of a document, or nil if the
+// document is empty
+func (f *documentFile) meta(i int) (*metav1.PartialObjectMetadata, error) {
+	if isEmptyDocument(f.docs[i]) {
+		return nil, nil
+	}
+	meta := &metav1.PartialObjectMetadata{}
+	if err := yaml.Unmarshal([]byte(f.docs[i]), meta); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", f.path, err)
+	}
+	return meta, nil
+}
+
+// set replaces the document at index i with the given content, keeping the comment
+// lines on top of the document. The content is appended as new document if i is -1.
+func (f *documentFile) set(i int, content []byte) {
+	doc := string(content)
+	if !strings.HasSuffix(doc, "\n") {
+		doc += "\n"
+	}
+	if i >= 0 {
+		f.docs[i] = headComment(f.docs[i]) + doc
+		return
+	}
+	if f.isEmpty() {
+		f.docs = []string{doc}
+		f.separators = nil
+		return
+	}
+	last := len(f.docs) - 1
+	if isEmptyDocument(f.docs[last]) && !strings.Contains(f.docs[last], "#") {
+		// reuse a trailing empty document, like the one after a final '---'
+		f.docs[last] = doc
+		return
+	}
+	if !strings.HasSuffix(f.docs[last], "\n") {
+		f.docs[last] += "\n"
+	}
+	f.separators = append(f.separators, "---\n")
+	f.docs = append(f.docs, doc)
+}
+
+// remove deletes the document at index i together with its separator
+func (f *documentFile) remove(i int) {
+	f.docs = append(f.docs[:i], f.docs[i+1:]...)
+	if len(f.separators) == 0 {
+		return
+	}
+	sep := i - 1
+	if sep < 0 {
+		sep = 0
+	}
+	f.separators = append(f.separators[:sep], f.separators[sep+1:]...)
+}
+
+// isEmpty returns true if none of the documents has any content
+func (f *documentFile) isEmpty() bool {
+	for _, doc := range f.docs {
+		if !isEmptyDocument(doc) {
+			return false
+		}
+	}
+	return true
+}
+
+// save writes the documents back to the file
+func (f *documentFile) save() error {
+	var buf bytes.Buffer
+	for i, doc := range f.docs {
+		if i > 0 {
+			buf.WriteString(f.separators[i-1])
+		}
+		buf.WriteString(doc)
+	}
+	return os.WriteFile(f.path, buf.Bytes(), 0644)
+}
+
+// headComment returns the comment and empty lines on top of a document
+func headComment(doc string) string {
+	var head strings.Builder
+	for _, line := range strings.SplitAfter(doc, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+		head.WriteString(line)
+	}
+	return head.String()
+}
+
+// isEmptyDocument returns true if the document holds only comments and empty lines
+func isEmptyDocument(doc string) bool {
+	return len(headComment(doc)) == len(doc)
+}
+
+func namespaceMatches(docNamespace, namespace string) bool {
+	return namespace == "" || docNamespace == "" || docNamespace == namespace
+}
@@ -0,0 +1,190 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// kustomizationFileNames are the file names recognized by kustomize
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// findKustomization returns the path of the kustomization file in the given
+// directory, or an empty string if there is none
+func findKustomization(dir string) string {
+	for _, name := range kustomizationFileNames {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// kustomization is a kustomization file of which only the resources list is
+// of interest. Changes to the list are made on the raw text, so that the
+// rest of the file stays as it is.
+type kustomization struct {
+	path string
+	data []byte
+	root *yaml.Node
+}
+
+func readKustomization(path string) (*kustomization, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	k := &kustomization{path: path, data: data}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+	if len(doc.Content) > 0 {
+		k.root = doc.Content[0]
+	}
+	if k.root != nil && k.root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("cannot read %s: not a kustomization", path)
+	}
+	return k, nil
+}
+
+// resources returns the entries of the resources list
+func (k *kustomization) resources() []string {
+	_, seq := k.resourcesNode()
+	if seq == nil || seq.Kind != yaml.SequenceNode {
+		return nil
+	}
+	var resources []string
+	for _, item := range seq.Content {
+		resources = append(resources, item.Value)
+	}
+	return resources
+}
+
+// resourcesNode returns the key and the value node of the resources list
+func (k *kustomization) resourcesNode() (*yaml.Node, *yaml.Node) {
+	if k.root == nil {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(k.root.Content); i += 2 {
+		if k.root.Content[i].Value == "resources" {
+			return k.root.Content[i], k.root.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// addResource adds the given entry to the resources list, if it's not yet part of it
+func (k *kustomization) addResource(resource string) error {
+	for _, r := range k.resources() {
+		if r == resource {
+			return nil
+		}
+	}
+	key, seq := k.resourcesNode()
+	lines := strings.SplitAfter(string(k.data), "\n")
+	switch {
+	case key == nil:
+		content := string(k.data)
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		return k.save([]byte(content + "resources:\n- " + resource + "\n"))
+	case seq.Kind == yaml.ScalarNode && seq.Tag == "!!null":
+		// "resources:" without any entries
+		return k.save(insertLine(lines, key.Line, "- "+resource+"\n"))
+	case seq.Kind == yaml.SequenceNode && seq.Style&yaml.FlowStyle == 0 && len(seq.Content) > 0:
+		last := seq.Content[len(seq.Content)-1]
+		indent := strings.Repeat(" ", last.Column-3)
+		return k.save(insertLine(lines, last.Line, indent+"- "+resource+"\n"))
+	case seq.Kind == yaml.SequenceNode:
+		if len(seq.Content) == 0 {
+			// turn "resources: []" into a block style list
+			seq.Style = 0
+		}
+		seq.Content = append(seq.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: resource})
+		return k.encode()
+	}
+	return fmt.Errorf("cannot add %s to %s: unexpected format of resources", resource, k.path)
+}
+
+// removeResource removes the given entry from the resources list
+func (k *kustomization) removeResource(resource string) error {
+	_, seq := k.resourcesNode()
+	if seq == nil || seq.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for i, item := range seq.Content {
+		if item.Value != resource {
+			continue
+		}
+		if seq.Style&yaml.FlowStyle != 0 {
+			seq.Content = append(seq.Content[:i], seq.Content[i+1:]...)
+			return k.encode()
+		}
+		lines := strings.SplitAfter(string(k.data), "\n")
+		lines = append(lines[:item.Line-1], lines[item.Line:]...)
+		return k.save([]byte(strings.Join(lines, "")))
+	}
+	return nil
+}
+
+// encode writes the whole file from the parsed nodes. This is only used for
+// flow style lists, which can't be changed line by line.
+func (k *kustomization) encode() error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(k.root); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	return k.save(buf.Bytes())
+}
+
+func (k *kustomization) save(data []byte) error {
+	if err := os.WriteFile(k.path, data, 0644); err != nil {
+		return err
+	}
+	updated, err := readKustomization(k.path)
+	if err != nil {
+		return err
+	}
+	*k = *updated
+	return nil
+}
+
+// insertLine inserts the line after the given 1-based line number
+func insertLine(lines []string, after int, line string) []byte {
+	if after > len(lines) {
+		after = len(lines)
+	}
+	if after > 0 && !strings.HasSuffix(lines[after-1], "\n") {
+		lines[after-1] += "\n"
+	}
+	result := append([]string{}, lines[:after]...)
+	result = append(result, line)
+	result = append(result, lines[after:]...)
+	return []byte(strings.Join(result, ""))
+}
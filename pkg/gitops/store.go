@@ -0,0 +1,362 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+)
+
+// Object is a pointer to a Kubernetes resource which can be stored in a file
+type Object[T any] interface {
+	*T
+	metav1.Object
+	runtime.Object
+}
+
+// Store keeps resources of a single kind in local files instead of a cluster.
+// The target is one of:
+//
+//   - a directory, in which every resource is stored in its own file
+//     with the layout <dir>/<namespace>/<kind>/<name>.yaml
+//   - a directory with a kustomization.yaml, in which resources are looked up in the
+//     files listed as resources of the kustomization. New resources are written
+//     to <dir>/<kind>-<name>.yaml and added to the resources list.
+//   - a .yaml or .yml file, which can hold any number of resources as separate documents
+//   - a .json file, which holds exactly one resource
+//
+// Resources are matched by group, kind and name. Documents holding other resources are
+// kept as they are, including their comments. When a file is added to or removed
+// from a directory which holds a kustomization.yaml, its resources list is updated.
+type Store[T any, PT Object[T]] struct {
+	dir        string
+	namespace  string
+	kindDir    string
+	kind       schema.GroupKind
+	resource   schema.GroupResource
+	fileMode   bool
+	fileFormat string
+	updateGVK  func(obj runtime.Object) error
+}
+
+// NewStore creates a store for resources which are kept below the directory kindDir.
+// The updateGVK function must set the group, version and kind of a resource, which
+// are written to the file and used to tell apart resources of different kinds.
+func NewStore[T any, PT Object[T]](namespace, dir, kindDir string, resource schema.GroupResource, updateGVK func(obj runtime.Object) error) *Store[T, PT] {
+	fileMode, fileFormat := FileModeAndFormat(dir)
+	store := &Store[T, PT]{
+		dir:        dir,
+		namespace:  namespace,
+		kindDir:    kindDir,
+		resource:   resource,
+		fileMode:   fileMode,
+		fileFormat: fileFormat,
+		updateGVK:  updateGVK,
+	}
+	var empty PT = new(T)
+	if err := updateGVK(empty); err == nil {
+		store.kind = empty.GetObjectKind().GroupVersionKind().GroupKind()
+	}
+	return store
+}
+
+// FileModeAndFormat returns whether the target is a single file and the format
+// ("yaml" or "json") in which resources are written
+func FileModeAndFormat(target string) (bool, string) {
+	switch {
+	case strings.HasSuffix(target, ".yaml"), strings.HasSuffix(target, ".yml"):
+		return true, "yaml"
+	case strings.HasSuffix(target, ".json"):
+		return true, "json"
+	}
+	return false, "yaml"
+}
+
+// Namespace returns the namespace of the store
+func (s *Store[T, PT]) Namespace() string {
+	return s.namespace
+}
+
+// FilePath returns the path of the file to which a new resource with the given name is written
+func (s *Store[T, PT]) FilePath(name string) string {
+	switch {
+	case s.fileMode:
+		return s.dir
+	case s.kustomization() != "":
+		return filepath.Join(s.dir, s.kindDir+"-"+name+".yaml")
+	}
+	return filepath.Join(s.dir, s.namespace, s.kindDir, name+".yaml")
+}
+
+// Get reads the resource with the given name
+func (s *Store[T, PT]) Get(name string) (PT, error) {
+	file, idx, err := s.locate(name)
+	if err != nil {
+		return nil, err
+	}
+	if idx < 0 {
+		return nil, apierrors.NewNotFound(s.resource, name)
+	}
+	return s.decode(file, idx)
+}
+
+// List reads all resources of the store's namespace, or of all namespaces
+// if the store has been created for an empty namespace
+func (s *Store[T, PT]) List() ([]T, error) {
+	paths, err := s.listFiles()
+	if err != nil {
+		return nil, err
+	}
+	items := []T{}
+	for _, path := range paths {
+		file, err := readDocumentFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for i := range file.docs {
+			meta, err := file.meta(i)
+			if err != nil {
+				return nil, err
+			}
+			if meta == nil || !isKind(meta, s.kind) || !namespaceMatches(meta.Namespace, s.matchNamespace()) {
+				continue
+			}
+			obj, err := s.decode(file, i)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, *obj)
+		}
+	}
+	return items, nil
+}
+
+// Create writes a new resource. An error is returned if the resource already exists.
+func (s *Store[T, PT]) Create(obj PT) error {
+	_, err := s.Get(obj.GetName())
+	if err == nil {
+		return apierrors.NewAlreadyExists(s.resource, obj.GetName())
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	return s.Write(obj)
+}
+
+// Update overwrites an existing resource
+func (s *Store[T, PT]) Update(obj PT) error {
+	if _, err := s.Get(obj.GetName()); err != nil {
+		return err
+	}
+	return s.Write(obj)
+}
+
+// Write stores the resource, regardless of whether it already exists or not
+func (s *Store[T, PT]) Write(obj PT) error {
+	if !s.fileMode {
+		if _, err := os.Stat(s.dir); os.IsNotExist(err) {
+			return fmt.Errorf("directory '%s' not present, please create the directory and try again", s.dir)
+		}
+	}
+	if err := s.updateGVK(obj); err != nil {
+		return err
+	}
+	file, idx, err := s.locate(obj.GetName())
+	if err != nil {
+		return err
+	}
+	content, err := s.print(obj)
+	if err != nil {
+		return err
+	}
+	if s.fileFormat == "json" && idx < 0 && !file.isEmpty() {
+		// a JSON file can't hold more than one resource
+		return fmt.Errorf("cannot write %s '%s' to %s: file already holds another resource", s.kind.Kind, obj.GetName(), file.path)
+	}
+	file.set(idx, content)
+	if err := os.MkdirAll(filepath.Dir(file.path), 0755); err != nil {
+		return err
+	}
+	if err := file.save(); err != nil {
+		return err
+	}
+	if !file.exists {
+		return updateKustomization(file.path, (*kustomization).addResource)
+	}
+	return nil
+}
+
+// Delete removes the resource with the given name. A file which doesn't hold
+// any other resource is removed, too.
+func (s *Store[T, PT]) Delete(name string) error {
+	file, idx, err := s.locate(name)
+	if err != nil {
+		return err
+	}
+	if idx < 0 {
+		return apierrors.NewNotFound(s.resource, name)
+	}
+	file.remove(idx)
+	if !file.isEmpty() {
+		return file.save()
+	}
+	if err := os.Remove(file.path); err != nil {
+		return err
+	}
+	return updateKustomization(file.path, (*kustomization).removeResource)
+}
+
+// locate returns the file which holds the resource with the given name and the
+// index of its document. If the resource doesn't exist, the index is -1 and the
+// file is the one to which the resource is written when created.
+func (s *Store[T, PT]) locate(name string) (*documentFile, int, error) {
+	if !s.fileMode {
+		if k := s.kustomization(); k != "" {
+			paths, err := kustomizationResourceFiles(k)
+			if err != nil {
+				return nil, -1, err
+			}
+			for _, path := range paths {
+				file, idx, err := s.find(path, name)
+				if err != nil || idx >= 0 {
+					return file, idx, err
+				}
+			}
+		}
+	}
+	return s.find(s.FilePath(name), name)
+}
+
+func (s *Store[T, PT]) find(path, name string) (*documentFile, int, error) {
+	file, err := readDocumentFile(path)
+	if err != nil {
+		return nil, -1, err
+	}
+	idx, err := file.find(s.kind, name, s.matchNamespace())
+	return file, idx, err
+}
+
+// listFiles returns all files which may hold resources of the store
+func (s *Store[T, PT]) listFiles() ([]string, error) {
+	if s.fileMode {
+		return []string{s.dir}, nil
+	}
+	if _, err := os.Stat(s.dir); err != nil {
+		return nil, err
+	}
+	if k := s.kustomization(); k != "" {
+		return kustomizationResourceFiles(k)
+	}
+	namespace := s.namespace
+	if namespace == "" {
+		namespace = "*"
+	}
+	return filepath.Glob(filepath.Join(s.dir, namespace, s.kindDir, "*.yaml"))
+}
+
+// matchNamespace returns the namespace which resources in a file must have. In the
+// directory layout, the namespace is given by the path of the file instead.
+func (s *Store[T, PT]) matchNamespace() string {
+	if s.fileMode || s.kustomization() != "" {
+		return s.namespace
+	}
+	return ""
+}
+
+// kustomization returns the kustomization file of the target directory, if any
+func (s *Store[T, PT]) kustomization() string {
+	if s.fileMode {
+		return ""
+	}
+	return findKustomization(s.dir)
+}
+
+func (s *Store[T, PT]) decode(file *documentFile, idx int) (PT, error) {
+	var obj PT = new(T)
+	if err := yaml.Unmarshal([]byte(file.docs[idx]), obj); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", file.path, err)
+	}
+	return obj, nil
+}
+
+func (s *Store[T, PT]) print(obj PT) ([]byte, error) {
+	printer, err := genericclioptions.NewJSONYamlPrintFlags().ToPrinter(s.fileFormat)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := printer.PrintObj(obj, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// kustomizationResourceFiles returns the local files listed as resources of the
+// given kustomization, including those of kustomizations in listed directories
+func kustomizationResourceFiles(path string) ([]string, error) {
+	k, err := readKustomization(path)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(path)
+	var files []string
+	for _, resource := range k.resources() {
+		if strings.Contains(resource, "://") {
+			// remote resources can't be changed
+			continue
+		}
+		resourcePath := filepath.Join(dir, resource)
+		info, err := os.Stat(resourcePath)
+		if err != nil {
+			continue
+		}
+		if !info.IsDir() {
+			files = append(files, resourcePath)
+			continue
+		}
+		if nested := findKustomization(resourcePath); nested != "" {
+			nestedFiles, err := kustomizationResourceFiles(nested)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, nestedFiles...)
+		}
+	}
+	return files, nil
+}
+
+// updateKustomization applies the change to the resources list of the kustomization
+// next to the given file, if there is one
+func updateKustomization(file string, change func(*kustomization, string) error) error {
+	path := findKustomization(filepath.Dir(file))
+	if path == "" {
+		return nil
+	}
+	k, err := readKustomization(path)
+	if err != nil {
+		return err
+	}
+	return change(k, filepath.Base(file))
+}
@@ -0,0 +1,360 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestFileModeAndFormat(t *testing.T) {
+	for _, c := range []struct {
+		target   string
+		fileMode bool
+		format   string
+	}{
+		{"/tmp/dir", false, "yaml"},
+		{"/tmp/file.yaml", true, "yaml"},
+		{"/tmp/file.yml", true, "yaml"},
+		{"/tmp/file.json", true, "json"},
+	} {
+		fileMode, format := FileModeAndFormat(c.target)
+		assert.Equal(t, fileMode, c.fileMode, c.target)
+		assert.Equal(t, format, c.format, c.target)
+	}
+}
+
+func TestStoreDirectory(t *testing.T) {
+	dir := t.TempDir()
+	fooStore := newTriggerStore("foo-ns", dir)
+	barStore := newTriggerStore("bar-ns", dir)
+	allStore := newTriggerStore("", dir)
+
+	t.Run("file path", func(t *testing.T) {
+		assert.Equal(t, fooStore.FilePath("t1"), filepath.Join(dir, "foo-ns", "trigger", "t1.yaml"))
+	})
+	t.Run("create", func(t *testing.T) {
+		assert.NilError(t, fooStore.Create(newTrigger("t1", "b1")))
+		assert.NilError(t, fooStore.Create(newTrigger("t2", "b1")))
+		assert.NilError(t, barStore.Create(newTrigger("t1", "b2")))
+
+		err := fooStore.Create(newTrigger("t1", "b1"))
+		assert.ErrorType(t, err, apierrors.IsAlreadyExists)
+	})
+	t.Run("get", func(t *testing.T) {
+		trigger, err := fooStore.Get("t1")
+		assert.NilError(t, err)
+		assert.Equal(t, trigger.Kind, "Trigger")
+		assert.Equal(t, trigger.Spec.Broker, "b1")
+
+		_, err = fooStore.Get("t3")
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+		assert.ErrorContains(t, err, `"t3" not found`)
+	})
+	t.Run("list", func(t *testing.T) {
+		items, err := fooStore.List()
+		assert.NilError(t, err)
+		assert.Equal(t, len(items), 2)
+
+		items, err = allStore.List()
+		assert.NilError(t, err)
+		assert.Equal(t, len(items), 3)
+	})
+	t.Run("update", func(t *testing.T) {
+		assert.NilError(t, fooStore.Update(newTrigger("t1", "b3")))
+		trigger, err := fooStore.Get("t1")
+		assert.NilError(t, err)
+		assert.Equal(t, trigger.Spec.Broker, "b3")
+
+		err = fooStore.Update(newTrigger("t3", "b3"))
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+	})
+	t.Run("delete", func(t *testing.T) {
+		assert.NilError(t, fooStore.Delete("t1"))
+		_, err := fooStore.Get("t1")
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+
+		err = fooStore.Delete("t1")
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+	})
+	t.Run("missing directory", func(t *testing.T) {
+		store := newTriggerStore("foo-ns", filepath.Join(dir, "missing"))
+		err := store.Create(newTrigger("t1", "b1"))
+		assert.ErrorContains(t, err, "not present")
+	})
+}
+
+func TestStoreSingleFile(t *testing.T) {
+	for _, name := range []string{"test.yaml", "test.json"} {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), name)
+			store := newTriggerStore("", file)
+
+			items, err := store.List()
+			assert.NilError(t, err)
+			assert.Equal(t, len(items), 0)
+
+			assert.NilError(t, store.Create(newTrigger("t1", "b1")))
+			assert.Equal(t, store.FilePath("t1"), file)
+
+			trigger, err := store.Get("t1")
+			assert.NilError(t, err)
+			assert.Equal(t, trigger.Spec.Broker, "b1")
+
+			_, err = store.Get("t2")
+			assert.ErrorType(t, err, apierrors.IsNotFound)
+
+			items, err = store.List()
+			assert.NilError(t, err)
+			assert.Equal(t, len(items), 1)
+
+			assert.NilError(t, store.Delete("t1"))
+			_, err = os.Stat(file)
+			assert.Assert(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestStoreSingleFileOtherKind(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.yaml")
+	brokers := NewStore[eventingv1.Broker](
+		"", file, "broker", eventingv1.Resource("brokers"), updateGVK)
+	broker := &eventingv1.Broker{ObjectMeta: metav1.ObjectMeta{Name: "t1"}}
+	assert.NilError(t, brokers.Create(broker))
+
+	triggers := newTriggerStore("", file)
+	_, err := triggers.Get("t1")
+	assert.ErrorType(t, err, apierrors.IsNotFound)
+	items, err := triggers.List()
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 0)
+}
+
+func TestStoreSingleJSONFileOtherResource(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.json")
+	store := newTriggerStore("", file)
+	assert.NilError(t, store.Create(newTrigger("t1", "b1")))
+
+	err := store.Create(newTrigger("t2", "b2"))
+	assert.ErrorContains(t, err, "file already holds another resource")
+	trigger, err := store.Get("t1")
+	assert.NilError(t, err)
+	assert.Equal(t, trigger.Spec.Broker, "b1")
+}
+
+func TestStoreSameNameOtherGroup(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "all.yaml")
+	original := `apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: web
+`
+	assert.NilError(t, os.WriteFile(file, []byte(original), 0644))
+	store := NewStore[servingv1.Service]("", file, "ksvc", servingv1.Resource("services"), func(obj runtime.Object) error {
+		obj.GetObjectKind().SetGroupVersionKind(servingv1.SchemeGroupVersion.WithKind("Service"))
+		return nil
+	})
+
+	items, err := store.List()
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 1)
+	assert.Equal(t, items[0].APIVersion, "serving.knative.dev/v1")
+
+	service, err := store.Get("web")
+	assert.NilError(t, err)
+	service.Annotations = map[string]string{"updated": "true"}
+	assert.NilError(t, store.Update(service))
+	assert.Assert(t, strings.HasPrefix(readFile(t, file), "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\nspec:\n  ports:\n  - port: 80\n---\n"))
+	assert.Assert(t, strings.Contains(readFile(t, file), "updated: \"true\""))
+
+	assert.NilError(t, store.Delete("web"))
+	assert.Equal(t, readFile(t, file), "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\nspec:\n  ports:\n  - port: 80\n")
+	_, err = store.Get("web")
+	assert.ErrorType(t, err, apierrors.IsNotFound)
+}
+
+func newTriggerStore(namespace, dir string) *Store[eventingv1.Trigger, *eventingv1.Trigger] {
+	return NewStore[eventingv1.Trigger](namespace, dir, "trigger", eventingv1.Resource("triggers"), updateGVK)
+}
+
+func newTrigger(name, broker string) *eventingv1.Trigger {
+	return &eventingv1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       eventingv1.TriggerSpec{Broker: broker},
+	}
+}
+
+func updateGVK(obj runtime.Object) error {
+	var kind string
+	switch obj.(type) {
+	case *eventingv1.Trigger:
+		kind = "Trigger"
+	case *eventingv1.Broker:
+		kind = "Broker"
+	}
+	obj.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
+		Group: "eventing.knative.dev", Version: "v1", Kind: kind})
+	return nil
+}
+
+func TestStoreMultiDocumentFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "all.yaml")
+	original := `# Our broker
+apiVersion: eventing.knative.dev/v1
+kind: Broker
+metadata:
+  name: default # keep this
+---
+# The trigger
+apiVersion: eventing.knative.dev/v1
+kind: Trigger
+metadata:
+  name: t1
+spec:
+  broker: default
+--- # services follow
+apiVersion: v1
+kind: Service
+metadata:
+  name: t1
+`
+	assert.NilError(t, os.WriteFile(file, []byte(original), 0644))
+	store := newTriggerStore("", file)
+
+	trigger, err := store.Get("t1")
+	assert.NilError(t, err)
+	assert.Equal(t, trigger.Spec.Broker, "default")
+
+	items, err := store.List()
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 1)
+
+	assert.NilError(t, store.Update(newTrigger("t1", "other")))
+	content := readFile(t, file)
+	assert.Assert(t, strings.HasPrefix(content, "# Our broker\napiVersion: eventing.knative.dev/v1\nkind: Broker\nmetadata:\n  name: default # keep this\n---\n# The trigger\n"))
+	assert.Assert(t, strings.Contains(content, "broker: other"))
+	assert.Assert(t, strings.HasSuffix(content, "--- # services follow\napiVersion: v1\nkind: Service\nmetadata:\n  name: t1\n"))
+
+	assert.NilError(t, store.Create(newTrigger("t2", "default")))
+	content = readFile(t, file)
+	assert.Assert(t, strings.Contains(content, "  name: t1\n---\napiVersion: eventing.knative.dev/v1\nkind: Trigger\n"))
+	assert.Assert(t, strings.Contains(content, "name: t2"))
+	items, err = store.List()
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 2)
+
+	assert.NilError(t, store.Delete("t2"))
+	assert.NilError(t, store.Delete("t1"))
+	assert.Equal(t, readFile(t, file), "# Our broker\napiVersion: eventing.knative.dev/v1\nkind: Broker\nmetadata:\n  name: default # keep this\n--- # services follow\napiVersion: v1\nkind: Service\nmetadata:\n  name: t1\n")
+}
+
+func TestStoreKustomization(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "kustomization.yaml"), `# Managed by hand
+namespace: prod
+resources:
+  - base/triggers.yaml # all triggers
+  - other.yaml
+`)
+	writeFile(t, filepath.Join(dir, "base", "triggers.yaml"), `apiVersion: eventing.knative.dev/v1
+kind: Trigger
+metadata:
+  name: t1
+spec:
+  broker: default
+`)
+	writeFile(t, filepath.Join(dir, "other.yaml"), `apiVersion: eventing.knative.dev/v1
+kind: Broker
+metadata:
+  name: default
+`)
+	store := newTriggerStore("prod", dir)
+
+	trigger, err := store.Get("t1")
+	assert.NilError(t, err)
+	assert.Equal(t, trigger.Spec.Broker, "default")
+
+	assert.NilError(t, store.Update(newTrigger("t1", "other")))
+	assert.Assert(t, strings.Contains(readFile(t, filepath.Join(dir, "base", "triggers.yaml")), "broker: other"))
+
+	assert.NilError(t, store.Create(newTrigger("t2", "default")))
+	assert.Assert(t, strings.Contains(readFile(t, filepath.Join(dir, "trigger-t2.yaml")), "name: t2"))
+	assert.Equal(t, readFile(t, filepath.Join(dir, "kustomization.yaml")), `# Managed by hand
+namespace: prod
+resources:
+  - base/triggers.yaml # all triggers
+  - other.yaml
+  - trigger-t2.yaml
+`)
+
+	items, err := store.List()
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 2)
+
+	assert.NilError(t, store.Delete("t2"))
+	_, err = os.Stat(filepath.Join(dir, "trigger-t2.yaml"))
+	assert.Assert(t, os.IsNotExist(err))
+	assert.Equal(t, readFile(t, filepath.Join(dir, "kustomization.yaml")), `# Managed by hand
+namespace: prod
+resources:
+  - base/triggers.yaml # all triggers
+  - other.yaml
+`)
+}
+
+func TestStoreDirectoryWithKustomization(t *testing.T) {
+	dir := t.TempDir()
+	kustomization := filepath.Join(dir, "foo-ns", "trigger", "kustomization.yaml")
+	writeFile(t, kustomization, "resources: []\n")
+	store := newTriggerStore("foo-ns", dir)
+
+	assert.NilError(t, store.Create(newTrigger("t1", "default")))
+	assert.Equal(t, readFile(t, kustomization), "resources:\n  - t1.yaml\n")
+
+	assert.NilError(t, store.Delete("t1"))
+	assert.Equal(t, readFile(t, kustomization), "resources:\n")
+
+	assert.NilError(t, store.Create(newTrigger("t2", "default")))
+	assert.Equal(t, readFile(t, kustomization), "resources:\n- t2.yaml\n")
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	return string(content)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0644))
+}
@@ -0,0 +1,266 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logs streams the logs of all containers of a set of pods
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// colors used for the prefixes of pods, picked in turn
+var colors = []string{"\x1b[36m", "\x1b[33m", "\x1b[32m", "\x1b[35m", "\x1b[34m", "\x1b[31m"}
+
+const colorReset = "\x1b[0m"
+
+// Options selects the pods and containers whose logs are streamed
+type Options struct {
+	// Namespace of the pods
+	Namespace string
+	// LabelSelector selects the pods
+	LabelSelector string
+	// Container selects a single container. If empty, all containers except
+	// the queue-proxy are selected.
+	Container string
+	// Follow streams new log lines and logs of pods created later on
+	Follow bool
+	// Since only returns logs newer than the duration, if not zero
+	Since time.Duration
+	// Tail only returns the given number of most recent lines, if not negative
+	Tail int64
+	// Color prefixes the lines with colored pod names
+	Color bool
+}
+
+// Streamer writes the logs of multiple pods to a single writer, each line prefixed
+// with the pod and container it comes from
+type Streamer struct {
+	client kubernetes.Interface
+	opts   Options
+	out    io.Writer
+
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	streams map[string]bool
+	pods    map[string]string
+	errs    []error
+}
+
+// NewStreamer creates a new streamer for the pods selected by the options
+func NewStreamer(client kubernetes.Interface, opts Options, out io.Writer) *Streamer {
+	return &Streamer{
+		client:  client,
+		opts:    opts,
+		out:     out,
+		streams: map[string]bool{},
+		pods:    map[string]string{},
+	}
+}
+
+// Stream writes the logs of all selected pods. When following logs, Stream returns when the
+// context is cancelled, otherwise when all logs have been written. The number of pods found
+// initially is returned, so that the caller can tell that there weren't any.
+func (s *Streamer) Stream(ctx context.Context) (int, error) {
+	pods, err := s.client.CoreV1().Pods(s.opts.Namespace).List(ctx, metav1.ListOptions{LabelSelector: s.opts.LabelSelector})
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.Before(&pods.Items[j].CreationTimestamp)
+	})
+	for i := range pods.Items {
+		s.streamPod(ctx, &pods.Items[i])
+	}
+
+	if s.opts.Follow {
+		err = s.watch(ctx, pods.ResourceVersion)
+	}
+	s.wg.Wait()
+	if err != nil {
+		return len(pods.Items), err
+	}
+	return len(pods.Items), errors.Join(s.errs...)
+}
+
+// watch starts streaming the logs of pods which are added or whose containers start
+// later on, until the context is cancelled
+func (s *Streamer) watch(ctx context.Context, resourceVersion string) error {
+	watcher, err := s.client.CoreV1().Pods(s.opts.Namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector:   s.opts.LabelSelector,
+		ResourceVersion: resourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			pod, isPod := event.Object.(*corev1.Pod)
+			if !isPod {
+				continue
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				s.streamPod(ctx, pod)
+			case watch.Deleted:
+				s.forgetPod(pod)
+			}
+		}
+	}
+}
+
+// streamPod starts streaming the logs of all selected containers of the pod which
+// have started and aren't streamed yet. A restarted container is streamed again,
+// as the stream of its previous instance ends when it terminates. For a container
+// waiting to be restarted, e.g. in a crash loop, the logs of its terminated instance
+// are streamed.
+func (s *Streamer) streamPod(ctx context.Context, pod *corev1.Pod) {
+	for _, container := range s.containers(pod) {
+		status := containerStatus(pod, container)
+		if status == nil {
+			continue
+		}
+		previous := status.State.Waiting != nil && status.LastTerminationState.Terminated != nil
+		if status.State.Running == nil && status.State.Terminated == nil && !previous {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%d", pod.Name, container, status.RestartCount)
+		s.mu.Lock()
+		if s.streams[key] {
+			s.mu.Unlock()
+			continue
+		}
+		s.streams[key] = true
+		prefix := s.prefix(pod.Name, container)
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			if err := s.streamContainer(ctx, pod.Name, container, prefix, previous); err != nil && ctx.Err() == nil {
+				s.mu.Lock()
+				s.errs = append(s.errs, fmt.Errorf("cannot get logs of container '%s' of pod '%s': %w", container, pod.Name, err))
+				s.mu.Unlock()
+			}
+		}()
+	}
+}
+
+func (s *Streamer) streamContainer(ctx context.Context, pod, container, prefix string, previous bool) error {
+	logOptions := &corev1.PodLogOptions{Container: container, Follow: s.opts.Follow, Previous: previous}
+	if s.opts.Since > 0 {
+		seconds := int64(s.opts.Since.Seconds())
+		logOptions.SinceSeconds = &seconds
+	}
+	if s.opts.Tail >= 0 {
+		tail := s.opts.Tail
+		logOptions.TailLines = &tail
+	}
+	stream, err := s.client.CoreV1().Pods(s.opts.Namespace).GetLogs(pod, logOptions).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	reader := bufio.NewReader(stream)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			if line[len(line)-1] != '\n' {
+				line += "\n"
+			}
+			s.mu.Lock()
+			fmt.Fprint(s.out, prefix+line)
+			s.mu.Unlock()
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Streamer) forgetPod(pod *corev1.Pod) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.streams {
+		if strings.HasPrefix(key, pod.Name+"/") {
+			delete(s.streams, key)
+		}
+	}
+}
+
+// containers returns the names of the selected containers of the pod
+func (s *Streamer) containers(pod *corev1.Pod) []string {
+	var names []string
+	for _, container := range pod.Spec.Containers {
+		switch {
+		case s.opts.Container != "":
+			if container.Name == s.opts.Container {
+				names = append(names, container.Name)
+			}
+		case container.Name != clientservingv1.QueueProxyContainerName:
+			names = append(names, container.Name)
+		}
+	}
+	return names
+}
+
+// prefix returns the prefix of log lines of the container. Pods are colored in turn,
+// all containers of a pod get the same color. Must be called with the lock held.
+func (s *Streamer) prefix(pod, container string) string {
+	prefix := "[" + pod + " " + container + "] "
+	if !s.opts.Color {
+		return prefix
+	}
+	color, ok := s.pods[pod]
+	if !ok {
+		color = colors[len(s.pods)%len(colors)]
+		s.pods[pod] = color
+	}
+	return color + prefix + colorReset
+}
+
+// containerStatus returns the status of the container, or nil if it isn't known yet
+func containerStatus(pod *corev1.Pod, container string) *corev1.ContainerStatus {
+	for i := range pod.Status.ContainerStatuses {
+		if pod.Status.ContainerStatuses[i].Name == container {
+			return &pod.Status.ContainerStatuses[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+func TestStream(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newPod("foo-1", "foo", true),
+		newPod("foo-2", "foo", true),
+		newPod("foo-3", "foo", false),
+		newPod("bar-1", "bar", true),
+	)
+
+	for _, c := range []struct {
+		name      string
+		opts      Options
+		found     int
+		contained []string
+		missing   []string
+	}{
+		{
+			name:      "user containers",
+			opts:      Options{Namespace: "default", LabelSelector: "app=foo", Tail: -1},
+			found:     3,
+			contained: []string{"[foo-1 user-container] fake logs\n", "[foo-2 user-container] fake logs\n"},
+			missing:   []string{"queue-proxy", "foo-3", "bar-1"},
+		},
+		{
+			name:      "queue-proxy",
+			opts:      Options{Namespace: "default", LabelSelector: "app=foo", Container: clientservingv1.QueueProxyContainerName, Tail: -1},
+			found:     3,
+			contained: []string{"[foo-1 queue-proxy] fake logs\n", "[foo-2 queue-proxy] fake logs\n"},
+			missing:   []string{"user-container"},
+		},
+		{
+			name:      "colored",
+			opts:      Options{Namespace: "default", LabelSelector: "app=bar", Tail: 10, Color: true},
+			found:     1,
+			contained: []string{colors[0] + "[bar-1 user-container] " + colorReset + "fake logs\n"},
+		},
+		{
+			name:  "no pods",
+			opts:  Options{Namespace: "default", LabelSelector: "app=baz", Tail: -1},
+			found: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			found, err := NewStreamer(client, c.opts, out).Stream(context.Background())
+			assert.NilError(t, err)
+			assert.Equal(t, found, c.found)
+			for _, s := range c.contained {
+				assert.Assert(t, strings.Contains(out.String(), s), "%q doesn't contain %q", out.String(), s)
+			}
+			for _, s := range c.missing {
+				assert.Assert(t, !strings.Contains(out.String(), s), "%q contains %q", out.String(), s)
+			}
+		})
+	}
+}
+
+func TestStreamFollow(t *testing.T) {
+	client := fake.NewSimpleClientset(newPod("foo-1", "foo", true))
+	out := &syncBuffer{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error)
+	go func() {
+		_, err := NewStreamer(client, Options{Namespace: "default", LabelSelector: "app=foo", Follow: true, Tail: -1}, out).Stream(ctx)
+		done <- err
+	}()
+
+	waitFor(t, out, "[foo-1 user-container]")
+	// a pod which isn't running yet is picked up when its containers start
+	pod := newPod("foo-2", "foo", false)
+	_, err := client.CoreV1().Pods("default").Create(ctx, pod, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	pod.Status = newPod("foo-2", "foo", true).Status
+	_, err = client.CoreV1().Pods("default").UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	waitFor(t, out, "[foo-2 user-container]")
+
+	// a restarted container is streamed again
+	pod.Status.ContainerStatuses[0].RestartCount = 1
+	_, err = client.CoreV1().Pods("default").UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	for i := 0; i < 100 && strings.Count(out.String(), "[foo-2 user-container]") < 2; i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Equal(t, strings.Count(out.String(), "[foo-2 user-container]"), 2)
+
+	cancel()
+	assert.NilError(t, <-done)
+}
+
+func TestStreamCrashLoop(t *testing.T) {
+	pod := newPod("foo-1", "foo", true)
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+	}
+	pod.Status.ContainerStatuses[0].LastTerminationState = corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+	}
+	pod.Status.ContainerStatuses[0].RestartCount = 3
+	client := fake.NewSimpleClientset(pod)
+
+	out := &bytes.Buffer{}
+	found, err := NewStreamer(client, Options{Namespace: "default", LabelSelector: "app=foo", Tail: -1}, out).Stream(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, found, 1)
+	assert.Equal(t, out.String(), "[foo-1 user-container] fake logs\n")
+	var logOptions []*corev1.PodLogOptions
+	for _, action := range client.Actions() {
+		if action.GetSubresource() == "log" {
+			logOptions = append(logOptions, action.(clienttesting.GenericAction).GetValue().(*corev1.PodLogOptions))
+		}
+	}
+	assert.Equal(t, len(logOptions), 1)
+	assert.Equal(t, logOptions[0].Container, "user-container")
+	assert.Equal(t, logOptions[0].Previous, true)
+}
+
+func newPod(name, app string, running bool) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": app}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "user-container"}, {Name: clientservingv1.QueueProxyContainerName}},
+		},
+	}
+	for _, container := range pod.Spec.Containers {
+		status := corev1.ContainerStatus{Name: container.Name}
+		if running {
+			status.State.Running = &corev1.ContainerStateRunning{}
+		} else {
+			status.State.Waiting = &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}
+		}
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, status)
+	}
+	return pod
+}
+
+func waitFor(t *testing.T, out *syncBuffer, s string) {
+	for i := 0; i < 100; i++ {
+		if strings.Contains(out.String(), s) {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("%q doesn't contain %q", out.String(), s)
+}
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,122 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+
+	"knative.dev/client/pkg/gitops"
+)
+
+const (
+	channelKind      = "channel"
+	subscriptionKind = "subscription"
+)
+
+// messagingGitOpsClient works on channels and subscriptions
+// stored in a local directory instead of a remote cluster
+type messagingGitOpsClient struct {
+	namespace string
+	dir       string
+}
+
+// NewKnMessagingGitOpsClient returns a messaging client which works on the local path
+// given as dir, which is either a directory or a single file
+func NewKnMessagingGitOpsClient(namespace, dir string) KnMessagingClient {
+	return &messagingGitOpsClient{
+		namespace: namespace,
+		dir:       dir,
+	}
+}
+
+// ChannelsClient for channels in the local path
+func (c *messagingGitOpsClient) ChannelsClient() KnChannelsClient {
+	return &channelsGitOpsClient{
+		store: gitops.NewStore[messagingv1.Channel](c.namespace, c.dir, channelKind, messagingv1.Resource("channels"), updateMessagingGVK),
+	}
+}
+
+// SubscriptionsClient for subscriptions in the local path
+func (c *messagingGitOpsClient) SubscriptionsClient() KnSubscriptionsClient {
+	return &subscriptionsGitOpsClient{
+		store: gitops.NewStore[messagingv1.Subscription](c.namespace, c.dir, subscriptionKind, messagingv1.Resource("subscriptions"), updateMessagingGVK),
+	}
+}
+
+type channelsGitOpsClient struct {
+	store *gitops.Store[messagingv1.Channel, *messagingv1.Channel]
+}
+
+func (c *channelsGitOpsClient) GetChannel(ctx context.Context, name string) (*messagingv1.Channel, error) {
+	return c.store.Get(name)
+}
+
+func (c *channelsGitOpsClient) CreateChannel(ctx context.Context, channel *messagingv1.Channel) error {
+	return c.store.Create(channel)
+}
+
+func (c *channelsGitOpsClient) DeleteChannel(ctx context.Context, name string) error {
+	return c.store.Delete(name)
+}
+
+func (c *channelsGitOpsClient) ListChannel(ctx context.Context) (*messagingv1.ChannelList, error) {
+	items, err := c.store.List()
+	if err != nil {
+		return nil, err
+	}
+	return updateChannelListGVK(&messagingv1.ChannelList{Items: items})
+}
+
+func (c *channelsGitOpsClient) Namespace() string {
+	return c.store.Namespace()
+}
+
+type subscriptionsGitOpsClient struct {
+	store *gitops.Store[messagingv1.Subscription, *messagingv1.Subscription]
+}
+
+func (c *subscriptionsGitOpsClient) GetSubscription(ctx context.Context, name string) (*messagingv1.Subscription, error) {
+	return c.store.Get(name)
+}
+
+func (c *subscriptionsGitOpsClient) CreateSubscription(ctx context.Context, subscription *messagingv1.Subscription) error {
+	return c.store.Create(subscription)
+}
+
+func (c *subscriptionsGitOpsClient) UpdateSubscription(ctx context.Context, subscription *messagingv1.Subscription) error {
+	return c.store.Update(subscription)
+}
+
+func (c *subscriptionsGitOpsClient) UpdateSubscriptionWithRetry(ctx context.Context, name string, updateFunc SubscriptionUpdateFunc, nrRetries int) error {
+	return updateSubscriptionWithRetry(ctx, c, name, updateFunc, nrRetries)
+}
+
+func (c *subscriptionsGitOpsClient) DeleteSubscription(ctx context.Context, name string) error {
+	return c.store.Delete(name)
+}
+
+func (c *subscriptionsGitOpsClient) ListSubscription(ctx context.Context) (*messagingv1.SubscriptionList, error) {
+	items, err := c.store.List()
+	if err != nil {
+		return nil, err
+	}
+	return updateSubscriptionListGVK(&messagingv1.SubscriptionList{Items: items})
+}
+
+func (c *subscriptionsGitOpsClient) Namespace() string {
+	return c.store.Namespace()
+}
@@ -30,6 +30,12 @@ type Printer interface {
 	InputOutput
 }
 
+// NewPrinter returns a Printer which prints to the given input and output, like
+// the ones of a cobra.Command.
+func NewPrinter(io InputOutput) Printer {
+	return stdPrinter{io}
+}
+
 type stdPrinter struct {
 	InputOutput
 }
@@ -19,6 +19,8 @@ package tui
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/erikgeiser/promptkit/selection"
 	"knative.dev/client/pkg/output"
@@ -30,8 +32,14 @@ func NewChooser[T any](iw *InteractiveWidgets) Chooser[T] {
 	return &bubbleChooser[T]{iw.ctx}
 }
 
+// Chooser asks to choose one of the given options, which can be filtered by
+// typing a fuzzy search term.
 type Chooser[T any] interface {
+	// Choose returns the chosen option, exiting if the prompt fails.
 	Choose(options []T, format string, a ...any) T
+	// TryChoose returns the chosen option, or an error if the prompt fails or
+	// is aborted.
+	TryChoose(options []T, format string, a ...any) (T, error)
 }
 
 type bubbleChooser[T any] struct {
@@ -39,16 +47,33 @@ type bubbleChooser[T any] struct {
 }
 
 func (c *bubbleChooser[T]) Choose(options []T, format string, a ...any) T {
-	ctx := c.ctx
-	prt := output.PrinterFrom(ctx)
-	l := logging.LoggerFrom(ctx)
+	chosen, err := c.TryChoose(options, format, a...)
+	if err != nil {
+		logging.LoggerFrom(c.ctx).Fatal(err)
+	}
+	return chosen
+}
+
+func (c *bubbleChooser[T]) TryChoose(options []T, format string, a ...any) (T, error) {
+	prt := output.PrinterFrom(c.ctx)
 	sel := selection.New(fmt.Sprintf(format, a...), options)
-	sel.PageSize = 3
+	sel.PageSize = 10
+	sel.Filter = FilterFuzzy[T]
 	sel.Input = prt.InOrStdin()
 	sel.Output = prt.OutOrStdout()
-	chosen, err := sel.RunPrompt()
-	if err != nil {
-		l.Fatal(err)
+	return sel.RunPrompt()
+}
+
+// FilterFuzzy returns true if all characters of the filter appear in the
+// given order in the choice, ignoring case. So "hlw" matches "hello-world".
+func FilterFuzzy[T any](filter string, choice *selection.Choice[T]) bool {
+	rest := []rune(strings.ToLower(choice.String))
+	for _, r := range strings.ToLower(filter) {
+		i := slices.Index(rest, r)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+1:]
 	}
-	return chosen
+	return true
 }
@@ -0,0 +1,41 @@
+/*
+ Copyright 2026 The Knative Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package tui_test
+
+import (
+	"testing"
+
+	"github.com/erikgeiser/promptkit/selection"
+	"knative.dev/client/pkg/output/tui"
+)
+
+func TestFilterFuzzy(t *testing.T) {
+	t.Parallel()
+	choice := &selection.Choice[string]{String: "hello-World"}
+	for filter, want := range map[string]bool{
+		"":             true,
+		"hlw":          true,
+		"HELLO":        true,
+		"world":        true,
+		"wh":           false,
+		"hello-worlds": false,
+	} {
+		if got := tui.FilterFuzzy(filter, choice); got != want {
+			t.Errorf("filter %q: want %v, got %v", filter, want, got)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+/*
+ Copyright 2026 The Knative Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erikgeiser/promptkit/textinput"
+	"knative.dev/client/pkg/output"
+)
+
+// NewInput returns a new Input.
+func NewInput(iw *InteractiveWidgets) Input {
+	return &bubbleInput{iw.ctx}
+}
+
+// Input asks for a single line of text.
+type Input interface {
+	// Ask returns the entered text, which must not be empty, or an error if
+	// the prompt fails or is aborted.
+	Ask(format string, a ...any) (string, error)
+}
+
+type bubbleInput struct {
+	ctx context.Context
+}
+
+func (i *bubbleInput) Ask(format string, a ...any) (string, error) {
+	prt := output.PrinterFrom(i.ctx)
+	in := textinput.New(fmt.Sprintf(format, a...))
+	in.Input = prt.InOrStdin()
+	in.Output = prt.OutOrStdout()
+	return in.RunPrompt()
+}
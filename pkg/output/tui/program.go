@@ -0,0 +1,31 @@
+/*
+ Copyright 2026 The Knative Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"knative.dev/client/pkg/output"
+)
+
+// NewProgram returns a full-screen program running the given model, which
+// reads the keyboard input and renders to the output of the interactive
+// session. The program is stopped when the context is done.
+func (iw *InteractiveWidgets) NewProgram(model tea.Model) *tea.Program {
+	prt := output.PrinterFrom(iw.ctx)
+	opts := append(ioProgramOptions(prt), tea.WithAltScreen(), tea.WithContext(iw.ctx))
+	return tea.NewProgram(model, opts...)
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	colorReset = "\033[0m"
+	colorBold  = "\033[1m"
+	colorRed   = "\033[31m"
+	colorGreen = "\033[32m"
+	colorCyan  = "\033[36m"
+)
+
+// PrintUnifiedDiff prints a unified diff between the texts `from` and `to`, which are labeled
+// with the given names. Added and removed lines are colored if `color` is true.
+// It returns true if the texts differ.
+func PrintUnifiedDiff(out io.Writer, fromName, toName, from, to string, color bool) (bool, error) {
+	if from == to {
+		return false, nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if line == "" {
+			continue
+		}
+		if !color {
+			fmt.Fprint(out, line)
+			continue
+		}
+		lineColor := ""
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			lineColor = colorBold
+		case strings.HasPrefix(line, "@@"):
+			lineColor = colorCyan
+		case strings.HasPrefix(line, "+"):
+			lineColor = colorGreen
+		case strings.HasPrefix(line, "-"):
+			lineColor = colorRed
+		}
+		if lineColor == "" {
+			fmt.Fprint(out, line)
+			continue
+		}
+		fmt.Fprint(out, lineColor+strings.TrimSuffix(line, "\n")+colorReset+"\n")
+	}
+	return true, nil
+}
+
+// splitLines splits the text into lines, keeping the line endings
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
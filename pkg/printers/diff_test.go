@@ -0,0 +1,40 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPrintUnifiedDiff(t *testing.T) {
+	buf := &bytes.Buffer{}
+	differs, err := PrintUnifiedDiff(buf, "a", "b", "foo\nbar\n", "foo\nbar\n", false)
+	assert.NilError(t, err)
+	assert.Assert(t, !differs)
+	assert.Equal(t, buf.String(), "")
+
+	differs, err = PrintUnifiedDiff(buf, "a", "b", "foo\nbar\n", "foo\nbaz\n", false)
+	assert.NilError(t, err)
+	assert.Assert(t, differs)
+	assert.Equal(t, buf.String(), "--- a\n+++ b\n@@ -1,2 +1,2 @@\n foo\n-bar\n+baz\n")
+
+	buf.Reset()
+	_, err = PrintUnifiedDiff(buf, "a", "b", "foo\n", "bar\n", true)
+	assert.NilError(t, err)
+	assert.Equal(t, buf.String(), "\033[1m--- a\033[0m\n\033[1m+++ b\033[0m\n\033[36m@@ -1 +1 @@\033[0m\n\033[31m-foo\033[0m\n\033[32m+bar\033[0m\n")
+}
@@ -35,7 +35,10 @@ import (
 	"knative.dev/client/pkg/commands/channel"
 	"knative.dev/client/pkg/commands/completion"
 	"knative.dev/client/pkg/commands/container"
+	"knative.dev/client/pkg/commands/dashboard"
 	"knative.dev/client/pkg/commands/domain"
+	"knative.dev/client/pkg/commands/event"
+	"knative.dev/client/pkg/commands/eventing"
 	"knative.dev/client/pkg/commands/eventtype"
 	"knative.dev/client/pkg/commands/options"
 	"knative.dev/client/pkg/commands/plugin"
@@ -88,6 +91,9 @@ Find more information about Knative at: https://knative.dev`, rootName),
 	p.Params.SetFlags(rootCmd.PersistentFlags())
 
 	flags.AddBothBoolFlags(rootCmd.PersistentFlags(), &p.LogHTTP, "log-http", "", false, "log http traffic")
+	// prompts are disabled in CI, too
+	flags.AddBothBoolFlags(rootCmd.PersistentFlags(), &p.Input, "input", "", true,
+		"Ask interactively for a missing name and required flags when running in a terminal.")
 
 	// Grouped commands
 	groups := templates.CommandGroups{
@@ -99,6 +105,7 @@ Find more information about Knative at: https://knative.dev`, rootName),
 				route.NewRouteCommand(p),
 				domain.NewDomainCommand(p),
 				container.NewContainerCommand(p),
+				dashboard.NewDashboardCommand(p),
 			},
 		},
 		{
@@ -110,6 +117,8 @@ Find more information about Knative at: https://knative.dev`, rootName),
 				channel.NewChannelCommand(p),
 				subscription.NewSubscriptionCommand(p),
 				eventtype.NewEventTypeCommand(p),
+				event.NewEventCommand(p),
+				eventing.NewEventingCommand(p),
 			},
 		},
 		{
@@ -131,6 +140,9 @@ Find more information about Knative at: https://knative.dev`, rootName),
 	// Add the "options" commands for showing all global options
 	rootCmd.AddCommand(options.NewOptionsCommand())
 
+	// Ask for missing names and required flags in interactive sessions, for all commands alike
+	commands.AddInteractivePrompts(rootCmd, p)
+
 	// Check that command groups can't execute and that leaf commands don't h
 	err := validateCommandStructure(rootCmd)
 	if err != nil {
@@ -23,6 +23,22 @@ import (
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
+// IgnoredRevisionAnnotations defines the annotation keys of a revision which are
+// maintained by Knative or kn and don't belong to its configuration
+var IgnoredRevisionAnnotations = []string{
+	"serving.knative.dev/lastPinned",
+	"serving.knative.dev/creator",
+	"serving.knative.dev/routingStateModified",
+	UpdateTimestampAnnotationKey,
+}
+
+// IgnoredRevisionLabels defines the label keys of a revision which are
+// maintained by Knative and don't belong to its configuration
+var IgnoredRevisionLabels = []string{
+	"serving.knative.dev/configurationUID",
+	"serving.knative.dev/serviceUID",
+}
+
 type Scaling struct {
 	Min *int
 	Max *int
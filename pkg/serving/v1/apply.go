@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -35,17 +36,16 @@ import (
 // Helper methods supporting Apply()
 
 // patch performs a 3-way merge and returns whether the original service has been changed
-// This method uses a simple JSON 3-way merge which has some severe limitations, like that arrays
-// can't be merged. Ideally a strategicpatch merge should be used, which allows a more fine grained
-// way for performing the merge (but this is not supported for custom resources)
-// See issue https://github.com/knative/client/issues/1073 for more details how this method should be
-// improved for a better merge strategy.
+// The merge is aware of the Knative Service schema, so that keyed lists like containers, env vars,
+// volumes or traffic targets are merged entry by entry (similar to a strategic merge patch), instead
+// of replacing them as a whole. As strategic merge patches are not supported by the API server for
+// custom resources, the merge is performed on the client side and the result is sent as a JSON merge patch.
 func (cl *knServingClient) patch(ctx context.Context, modifiedService *servingv1.Service, currentService *servingv1.Service, uOriginalService []byte) (bool, error) {
 	uModifiedService, err := getModifiedConfiguration(modifiedService, true)
 	if err != nil {
 		return false, err
 	}
-	hasChanged, err := cl.patchSimple(ctx, currentService, uModifiedService, uOriginalService)
+	patchedService, hasChanged, err := cl.patchSimple(ctx, currentService, uModifiedService, uOriginalService)
 	for i := 1; i <= 5 && apierrors.IsConflict(err); i++ {
 		if i > 1 {
 			time.Sleep(1 * time.Second)
@@ -54,38 +54,70 @@ func (cl *knServingClient) patch(ctx context.Context, modifiedService *servingv1
 		if err != nil {
 			return false, err
 		}
-		hasChanged, err = cl.patchSimple(ctx, currentService, uModifiedService, uOriginalService)
+		patchedService, hasChanged, err = cl.patchSimple(ctx, currentService, uModifiedService, uOriginalService)
 	}
-	return hasChanged, err
+	if err != nil {
+		return false, err
+	}
+	if DryRunFrom(ctx) != DryRunNone {
+		// Hand back the service as it would have been stored
+		patchedService.DeepCopyInto(modifiedService)
+	}
+	return hasChanged, nil
 }
 
-func (cl *knServingClient) patchSimple(ctx context.Context, currentService *servingv1.Service, uModifiedService []byte, uOriginalService []byte) (bool, error) {
+// patchSimple patches the current service and returns the patched service (or the current
+// service if nothing has to be patched) and whether the service has been changed
+func (cl *knServingClient) patchSimple(ctx context.Context, currentService *servingv1.Service, uModifiedService []byte, uOriginalService []byte) (*servingv1.Service, bool, error) {
 	// Serialize the current configuration of the object from the server.
 	uCurrentService, err := encodeService(currentService)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(uOriginalService, uModifiedService, uCurrentService)
 	if err != nil {
-		return false, err
+		return nil, false, err
+	}
+
+	if string(patch) == "{}" {
+		return currentService, false, nil
+	}
+
+	// A JSON merge patch replaces lists as a whole, so merge keyed lists
+	// entry-wise and calculate the patch from the current to the merged service
+	uMergedService, err := mergeServiceThreeWay(uOriginalService, uModifiedService, uCurrentService)
+	if err != nil {
+		return nil, false, err
+	}
+	patch, err = jsonmergepatch.CreateThreeWayJSONMergePatch(uCurrentService, uMergedService, uCurrentService)
+	if err != nil {
+		return nil, false, err
 	}
 
 	if string(patch) == "{}" {
-		return false, nil
+		return currentService, false, nil
+	}
+
+	if isClientDryRun(ctx) {
+		mergedService := &servingv1.Service{}
+		if err := json.Unmarshal(uMergedService, mergedService); err != nil {
+			return nil, false, err
+		}
+		return mergedService, true, updateServingGvk(mergedService)
 	}
 
 	// Check if the generation has been counted up, only then the backend detected a change
 	savedService, err := cl.patchService(ctx, currentService.Name, types.MergePatchType, patch)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
-	return savedService.Generation != savedService.Status.ObservedGeneration, nil
+	return savedService, savedService.Generation != savedService.Status.ObservedGeneration, nil
 }
 
 // patchService patches the given service
 func (cl *knServingClient) patchService(ctx context.Context, name string, patchType types.PatchType, patch []byte) (*servingv1.Service, error) {
-	service, err := cl.client.Services(cl.namespace).Patch(ctx, name, patchType, patch, metav1.PatchOptions{})
+	service, err := cl.client.Services(cl.namespace).Patch(ctx, name, patchType, patch, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,258 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Schema aware three-way merge used by Apply(), similar to a strategic merge patch
+// as used by "kubectl apply" for built-in types. In contrast to a JSON merge patch,
+// lists which are known to be keyed (like containers, env or volumes) are merged element
+// by element so that entries added to the service by others are preserved.
+
+// mergeKeyFunc extracts the key that identifies an entry in a keyed list.
+// An empty key means that the entry can only be identified by its position.
+type mergeKeyFunc func(entry map[string]interface{}) string
+
+// mergeKeyField returns a mergeKeyFunc which uses the value of the given field as key
+func mergeKeyField(field string) mergeKeyFunc {
+	return func(entry map[string]interface{}) string {
+		value, ok := entry[field]
+		if !ok || value == nil {
+			return ""
+		}
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// trafficMergeKey identifies a traffic target by its tag, or if no tag is given,
+// by the revision it points to.
+func trafficMergeKey(entry map[string]interface{}) string {
+	if tag, ok := entry["tag"].(string); ok && tag != "" {
+		return "tag:" + tag
+	}
+	if revision, ok := entry["revisionName"].(string); ok && revision != "" {
+		return "revision:" + revision
+	}
+	if latest, ok := entry["latestRevision"].(bool); ok && latest {
+		return "latest"
+	}
+	return ""
+}
+
+// containerMergeKeys are the merge keys for lists within a container
+var containerMergeKeys = map[string]mergeKeyFunc{
+	"env":          mergeKeyField("name"),
+	"ports":        mergeKeyField("containerPort"),
+	"volumeMounts": mergeKeyField("mountPath"),
+}
+
+// serviceMergeKeys maps the path of a list within a Knative Service to the function that
+// extracts the key of an entry. Lists not mentioned here are replaced as a whole.
+var serviceMergeKeys = buildServiceMergeKeys()
+
+func buildServiceMergeKeys() map[string]mergeKeyFunc {
+	keys := map[string]mergeKeyFunc{
+		"spec.traffic":                                 trafficMergeKey,
+		"spec.template.spec.containers":                mergeKeyField("name"),
+		"spec.template.spec.initContainers":            mergeKeyField("name"),
+		"spec.template.spec.volumes":                   mergeKeyField("name"),
+		"spec.template.spec.imagePullSecrets":          mergeKeyField("name"),
+		"spec.template.spec.tolerations":               mergeKeyField("key"),
+		"spec.template.spec.hostAliases":               mergeKeyField("ip"),
+		"spec.template.spec.topologySpreadConstraints": mergeKeyField("topologyKey"),
+	}
+	for _, containers := range []string{"spec.template.spec.containers", "spec.template.spec.initContainers"} {
+		for field, keyFunc := range containerMergeKeys {
+			keys[containers+"[]."+field] = keyFunc
+		}
+	}
+	return keys
+}
+
+// mergeServiceThreeWay merges the modified service configuration into the current service
+// configuration as found on the cluster, using the original configuration (as stored
+// from the last apply) for detecting fields that have been removed. All arguments are JSON
+// serialized services. The merged service is returned as JSON.
+func mergeServiceThreeWay(uOriginal, uModified, uCurrent []byte) ([]byte, error) {
+	original, err := decodeServiceMap(uOriginal, "original")
+	if err != nil {
+		return nil, err
+	}
+	modified, err := decodeServiceMap(uModified, "modified")
+	if err != nil {
+		return nil, err
+	}
+	current, err := decodeServiceMap(uCurrent, "current")
+	if err != nil {
+		return nil, err
+	}
+	merged := mergeMaps("", original, modified, current)
+	return json.Marshal(merged)
+}
+
+func decodeServiceMap(data []byte, what string) (map[string]interface{}, error) {
+	ret := map[string]interface{}{}
+	if len(data) == 0 {
+		return ret, nil
+	}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return nil, fmt.Errorf("cannot decode %s service configuration: %w", what, err)
+	}
+	return ret, nil
+}
+
+// mergeMaps merges modified into current. Fields which are part of the original
+// but not of the modified configuration are removed, all other fields found only
+// in current are kept.
+func mergeMaps(path string, original, modified, current map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(current))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key := range original {
+		if _, ok := modified[key]; !ok {
+			delete(merged, key)
+		}
+	}
+	for key, modifiedValue := range modified {
+		merged[key] = mergeValues(joinMergePath(path, key), original[key], modifiedValue, current[key])
+	}
+	return merged
+}
+
+func mergeValues(path string, original, modified, current interface{}) interface{} {
+	switch modifiedValue := modified.(type) {
+	case map[string]interface{}:
+		currentMap, ok := current.(map[string]interface{})
+		if !ok {
+			return modifiedValue
+		}
+		originalMap, _ := original.(map[string]interface{})
+		return mergeMaps(path, originalMap, modifiedValue, currentMap)
+	case []interface{}:
+		keyFunc, ok := serviceMergeKeys[path]
+		currentList, isList := current.([]interface{})
+		if !ok || !isList {
+			return modifiedValue
+		}
+		originalList, _ := original.([]interface{})
+		if path == "spec.traffic" {
+			return mergeTraffic(keyFunc, originalList, modifiedValue, currentList)
+		}
+		return mergeLists(path, keyFunc, originalList, modifiedValue, currentList)
+	default:
+		return modified
+	}
+}
+
+// mergeLists merges keyed lists entry by entry. The order of the modified list is kept,
+// entries only found in the current list are appended unless they were part of
+// the original list (in which case they have been removed by the user).
+// Entries without a key are matched by their position in the list.
+func mergeLists(path string, keyFunc mergeKeyFunc, original, modified, current []interface{}) []interface{} {
+	originalEntries := indexListEntries(keyFunc, original)
+	currentEntries := indexListEntries(keyFunc, current)
+
+	merged := make([]interface{}, 0, len(modified)+len(current))
+	usedCurrent := map[int]bool{}
+	for idx, entry := range modified {
+		modifiedEntry, ok := entry.(map[string]interface{})
+		if !ok {
+			// Not a list of objects, can't merge
+			return modified
+		}
+		key := listEntryKey(keyFunc, modifiedEntry, idx)
+		currentIdx, found := currentEntries[key]
+		if !found && idx < len(current) && !usedCurrent[idx] && keyFunc(modifiedEntry) == "" {
+			// An unnamed entry (like the single container of a service which gets
+			// a name assigned by the server) is matched by position
+			currentIdx, found = idx, true
+		}
+		if !found {
+			merged = append(merged, modifiedEntry)
+			continue
+		}
+		usedCurrent[currentIdx] = true
+		var originalEntry map[string]interface{}
+		if originalIdx, ok := originalEntries[key]; ok {
+			originalEntry, _ = original[originalIdx].(map[string]interface{})
+		}
+		currentEntry, _ := current[currentIdx].(map[string]interface{})
+		merged = append(merged, mergeMaps(path+"[]", originalEntry, modifiedEntry, currentEntry))
+	}
+
+	for idx, entry := range current {
+		if usedCurrent[idx] {
+			continue
+		}
+		currentEntry, ok := entry.(map[string]interface{})
+		if !ok {
+			return modified
+		}
+		if _, ok := originalEntries[listEntryKey(keyFunc, currentEntry, idx)]; ok {
+			// Entry has been applied before but is not part of the configuration anymore
+			continue
+		}
+		merged = append(merged, currentEntry)
+	}
+	return merged
+}
+
+// mergeTraffic merges the traffic targets like other keyed lists, but the percentages
+// of the applied configuration win, as they must add up to 100. Targets added by others
+// are kept for their tags, but don't receive any traffic anymore.
+func mergeTraffic(keyFunc mergeKeyFunc, original, modified, current []interface{}) []interface{} {
+	merged := mergeLists("spec.traffic", keyFunc, original, modified, current)
+	// the targets of the applied configuration come first, followed by the foreign ones
+	for _, entry := range merged[len(modified):] {
+		if target, ok := entry.(map[string]interface{}); ok {
+			if _, ok := target["percent"]; ok {
+				target["percent"] = 0
+			}
+		}
+	}
+	return merged
+}
+
+func indexListEntries(keyFunc mergeKeyFunc, list []interface{}) map[string]int {
+	ret := make(map[string]int, len(list))
+	for idx, entry := range list {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ret[listEntryKey(keyFunc, entryMap, idx)] = idx
+	}
+	return ret
+}
+
+func listEntryKey(keyFunc mergeKeyFunc, entry map[string]interface{}, idx int) string {
+	key := keyFunc(entry)
+	if key == "" {
+		return fmt.Sprintf("#%d", idx)
+	}
+	return key
+}
+
+func joinMergePath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return strings.Join([]string{path, key}, ".")
+}
@@ -0,0 +1,374 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMergeServiceThreeWay(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		modified string
+		current  string
+		want     string
+	}{
+		{
+			"Env var added by others is kept",
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        env:
+        - name: A
+          value: "1"
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        env:
+        - name: A
+          value: "2"
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        name: user-container
+        env:
+        - name: A
+          value: "1"
+        - name: B
+          value: "other"
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        name: user-container
+        env:
+        - name: A
+          value: "2"
+        - name: B
+          value: "other"
+`,
+		},
+		{
+			"Env var removed from configuration is removed",
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        env:
+        - name: A
+          value: "1"
+        - name: B
+          value: "2"
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        env:
+        - name: A
+          value: "1"
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        env:
+        - name: A
+          value: "1"
+        - name: B
+          value: "2"
+        - name: C
+          value: "3"
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        env:
+        - name: A
+          value: "1"
+        - name: C
+          value: "3"
+`,
+		},
+		{
+			"Containers merged by name",
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: foo
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: bar
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: foo
+        resources:
+          limits:
+            cpu: 100m
+      - name: sidecar
+        image: side
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: bar
+        resources:
+          limits:
+            cpu: 100m
+      - name: sidecar
+        image: side
+`,
+		},
+		{
+			"Volumes merged by name",
+			`
+spec:
+  template:
+    spec:
+      volumes:
+      - name: a
+        secret:
+          secretName: s1
+`,
+			`
+spec:
+  template:
+    spec:
+      volumes:
+      - name: a
+        secret:
+          secretName: s2
+`,
+			`
+spec:
+  template:
+    spec:
+      volumes:
+      - name: a
+        secret:
+          secretName: s1
+      - name: b
+        configMap:
+          name: cm
+`,
+			`
+spec:
+  template:
+    spec:
+      volumes:
+      - name: a
+        secret:
+          secretName: s2
+      - name: b
+        configMap:
+          name: cm
+`,
+		},
+		{
+			"Traffic merged by tag and revision",
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 100
+`,
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 90
+  - revisionName: foo-1
+    percent: 10
+`,
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 80
+  - tag: preview
+    revisionName: foo-2
+    percent: 20
+`,
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 90
+  - revisionName: foo-1
+    percent: 10
+  - tag: preview
+    revisionName: foo-2
+    percent: 0
+`,
+		},
+		{
+			"Foreign traffic targets don't receive traffic",
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 100
+`,
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 100
+`,
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 80
+  - tag: preview
+    revisionName: foo-2
+    percent: 20
+`,
+			`
+spec:
+  traffic:
+  - latestRevision: true
+    percent: 100
+  - tag: preview
+    revisionName: foo-2
+    percent: 0
+`,
+		},
+		{
+			"Unkeyed lists are replaced",
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        args: ["a", "b"]
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        args: ["c"]
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        args: ["a", "b", "x"]
+`,
+			`
+spec:
+  template:
+    spec:
+      containers:
+      - image: foo
+        args: ["c"]
+`,
+		},
+		{
+			"No original configuration",
+			``,
+			`
+metadata:
+  labels:
+    a: b
+`,
+			`
+metadata:
+  labels:
+    c: d
+`,
+			`
+metadata:
+  labels:
+    a: b
+    c: d
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeServiceThreeWay(yamlToJSON(t, tt.original), yamlToJSON(t, tt.modified), yamlToJSON(t, tt.current))
+			assert.NilError(t, err)
+
+			var gotMap, wantMap map[string]interface{}
+			assert.NilError(t, json.Unmarshal(got, &gotMap))
+			assert.NilError(t, yaml.Unmarshal([]byte(tt.want), &wantMap))
+			assert.DeepEqual(t, gotMap, wantMap)
+		})
+	}
+}
+
+func TestMergeServiceThreeWayInvalidJSON(t *testing.T) {
+	_, err := mergeServiceThreeWay([]byte("never"), []byte("{}"), []byte("{}"))
+	assert.ErrorContains(t, err, "original service configuration")
+}
+
+func yamlToJSON(t *testing.T, data string) []byte {
+	if data == "" {
+		return nil
+	}
+	ret, err := yaml.YAMLToJSON([]byte(data))
+	assert.NilError(t, err)
+	return ret
+}
@@ -0,0 +1,254 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clienterrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/util"
+)
+
+// DefaultFieldManager is the field manager used for server-side apply if none is given
+const DefaultFieldManager = "kn"
+
+// ServerSideApplyOptions holds the options for a server-side apply
+type ServerSideApplyOptions struct {
+	// FieldManager is the name of the actor that owns the applied fields
+	FieldManager string
+
+	// ForceConflicts takes over the ownership of fields which are managed by other field managers
+	ForceConflicts bool
+}
+
+// ServerSideApplyResult is the outcome of a server-side apply
+type ServerSideApplyResult struct {
+	// Created is true if the service did not exist before
+	Created bool
+
+	// Changed is true if the apply resulted in a new generation of the service
+	Changed bool
+
+	// ChangedFields contains the paths of all fields which have been changed
+	// on an existing service, sorted alphabetically
+	ChangedFields []string
+}
+
+// FieldManagerConflictError is returned when a server-side apply
+// would change fields which are owned by other field managers
+type FieldManagerConflictError struct {
+	// Name of the service
+	Name string
+
+	// Conflicts maps the name of a field manager to the conflicting fields it owns
+	Conflicts map[string][]string
+}
+
+func (e *FieldManagerConflictError) Error() string {
+	var managers []string
+	for manager := range e.Conflicts {
+		managers = append(managers, manager)
+	}
+	sort.Strings(managers)
+
+	lines := []string{fmt.Sprintf("cannot apply service '%s' because of conflicts with other field managers:", e.Name)}
+	for _, manager := range managers {
+		lines = append(lines, fmt.Sprintf("  %s:", manager))
+		for _, field := range e.Conflicts[manager] {
+			lines = append(lines, "    "+field)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ApplyServiceServerSide applies the given service with a Kubernetes server-side apply
+func (cl *knServingClient) ApplyServiceServerSide(ctx context.Context, service *servingv1.Service, opts ServerSideApplyOptions) (*ServerSideApplyResult, error) {
+	if isClientDryRun(ctx) {
+		return nil, errors.New("a server-side apply can't be performed as client dry-run")
+	}
+	currentService, err := cl.GetService(ctx, service.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err := verifyImageForApply(service, currentService); err != nil {
+		return nil, err
+	}
+
+	body, err := encodeServiceForServerSideApply(service)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldManager := opts.FieldManager
+	if fieldManager == "" {
+		fieldManager = DefaultFieldManager
+	}
+	force := opts.ForceConflicts
+	appliedService, err := cl.client.Services(cl.namespace).Patch(ctx, service.Name, types.ApplyPatchType, body, metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        &force,
+		DryRun:       dryRunOption(ctx),
+	})
+	if err != nil {
+		return nil, newServerSideApplyError(service.Name, err)
+	}
+	if err := updateServingGvk(appliedService); err != nil {
+		return nil, err
+	}
+	if isServerDryRun(ctx) {
+		appliedService.DeepCopyInto(service)
+	}
+
+	if currentService == nil {
+		return &ServerSideApplyResult{Created: true, Changed: true}, nil
+	}
+	changedFields, err := changedServiceFields(currentService, appliedService)
+	if err != nil {
+		return nil, err
+	}
+	return &ServerSideApplyResult{
+		Changed:       appliedService.Generation != currentService.Generation,
+		ChangedFields: changedFields,
+	}, nil
+}
+
+func verifyImageForApply(service *servingv1.Service, currentService *servingv1.Service) error {
+	containers := service.Spec.Template.Spec.Containers
+	if len(containers) == 0 || containers[0].Image == "" && currentService != nil {
+		return errors.New("'service apply' requires the image name to run provided with the --image option")
+	}
+	return nil
+}
+
+// encodeServiceForServerSideApply serializes the service without the fields managed by the server
+func encodeServiceForServerSideApply(service *servingv1.Service) ([]byte, error) {
+	service = service.DeepCopy()
+	if err := updateServingGvk(service); err != nil {
+		return nil, err
+	}
+	service.ManagedFields = nil
+	service.ResourceVersion = ""
+	if service.Annotations != nil {
+		delete(service.Annotations, corev1.LastAppliedConfigAnnotation)
+	}
+
+	uService, err := util.ToUnstructured(service)
+	if err != nil {
+		return nil, err
+	}
+	clearCreationTimestamps(uService.Object)
+	removeStatus(uService.Object)
+	return uService.MarshalJSON()
+}
+
+// newServerSideApplyError converts conflicts returned by the API server into a FieldManagerConflictError
+func newServerSideApplyError(name string, err error) error {
+	var statusErr *apierrors.StatusError
+	if !apierrors.IsConflict(err) || !errors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return clienterrors.GetError(err)
+	}
+	conflicts := map[string][]string{}
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager := extractFieldManager(cause.Message)
+		conflicts[manager] = append(conflicts[manager], cause.Field)
+	}
+	if len(conflicts) == 0 {
+		return clienterrors.GetError(err)
+	}
+	return &FieldManagerConflictError{Name: name, Conflicts: conflicts}
+}
+
+// extractFieldManager extracts the manager's name from a conflict message like
+// `conflict with "kubectl" using serving.knative.dev/v1`
+func extractFieldManager(message string) string {
+	rest := strings.TrimPrefix(message, "conflict with ")
+	quoted, err := strconv.QuotedPrefix(rest)
+	if err != nil {
+		return rest
+	}
+	manager, err := strconv.Unquote(quoted)
+	if err != nil {
+		return rest
+	}
+	return manager
+}
+
+// changedServiceFields returns the paths of all labels, annotations and spec fields
+// which differ between the given services
+func changedServiceFields(before *servingv1.Service, after *servingv1.Service) ([]string, error) {
+	uBefore, err := runtime.DefaultUnstructuredConverter.ToUnstructured(before)
+	if err != nil {
+		return nil, err
+	}
+	uAfter, err := runtime.DefaultUnstructuredConverter.ToUnstructured(after)
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for _, fields := range [][]string{{"metadata", "labels"}, {"metadata", "annotations"}, {"spec"}} {
+		valueBefore, _, _ := unstructured.NestedFieldNoCopy(uBefore, fields...)
+		valueAfter, _, _ := unstructured.NestedFieldNoCopy(uAfter, fields...)
+		collectChangedFields("."+strings.Join(fields, "."), valueBefore, valueAfter, &changed)
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+func collectChangedFields(path string, before, after interface{}, changed *[]string) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	mapBefore, okBefore := before.(map[string]interface{})
+	mapAfter, okAfter := after.(map[string]interface{})
+	if okBefore && okAfter {
+		keys := map[string]bool{}
+		for key := range mapBefore {
+			keys[key] = true
+		}
+		for key := range mapAfter {
+			keys[key] = true
+		}
+		for key := range keys {
+			collectChangedFields(path+"."+key, mapBefore[key], mapAfter[key], changed)
+		}
+		return
+	}
+	listBefore, okBefore := before.([]interface{})
+	listAfter, okAfter := after.([]interface{})
+	if okBefore && okAfter && len(listBefore) == len(listAfter) {
+		for idx := range listBefore {
+			collectChangedFields(fmt.Sprintf("%s[%d]", path, idx), listBefore[idx], listAfter[idx], changed)
+		}
+		return
+	}
+	*changed = append(*changed, path)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clienttesting "k8s.io/client-go/testing"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestApplyServiceServerSideCreate(t *testing.T) {
+	serving, client := setup()
+
+	serviceNew := newServiceWithImage("new-service", "test/image")
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewNotFound(servingv1.Resource("service"), a.(clienttesting.GetAction).GetName())
+		})
+	serving.AddReactor("patch", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			patchAction := a.(clienttesting.PatchAction)
+			assert.Equal(t, patchAction.GetPatchType(), types.ApplyPatchType)
+			assert.Assert(t, !strings.Contains(string(patchAction.GetPatch()), "status"))
+			return true, serviceNew, nil
+		})
+
+	result, err := client.ApplyServiceServerSide(context.Background(), serviceNew, ServerSideApplyOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, result.Created)
+	assert.Assert(t, result.Changed)
+}
+
+func TestApplyServiceServerSideUpdate(t *testing.T) {
+	serving, client := setup()
+
+	serviceOld := newServiceWithImage("my-service", "test/image")
+	serviceOld.Generation = 1
+	serviceNew := newServiceWithImage("my-service", "test/new-image")
+	serviceNew.Labels = map[string]string{"foo": "bar"}
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, serviceOld, nil
+		})
+	serving.AddReactor("patch", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, a.(clienttesting.PatchActionImpl).PatchOptions.FieldManager, "ci")
+			assert.Equal(t, *a.(clienttesting.PatchActionImpl).PatchOptions.Force, true)
+			applied := serviceNew.DeepCopy()
+			applied.Generation = 2
+			return true, applied, nil
+		})
+
+	result, err := client.ApplyServiceServerSide(context.Background(), serviceNew, ServerSideApplyOptions{FieldManager: "ci", ForceConflicts: true})
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Created)
+	assert.Assert(t, result.Changed)
+	assert.DeepEqual(t, result.ChangedFields, []string{".metadata.labels", ".spec.template.spec.containers[0].image"})
+}
+
+func TestApplyServiceServerSideConflict(t *testing.T) {
+	serving, client := setup()
+
+	serviceOld := newServiceWithImage("my-service", "test/image")
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, serviceOld, nil
+		})
+	serving.AddReactor("patch", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewApplyConflict([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kubectl" using serving.knative.dev/v1`,
+					Field:   ".spec.template.spec.containers[0].image",
+				},
+				{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "ci"`,
+					Field:   ".spec.traffic",
+				},
+			}, "Apply failed with 2 conflicts")
+		})
+
+	_, err := client.ApplyServiceServerSide(context.Background(), newServiceWithImage("my-service", "test/new-image"), ServerSideApplyOptions{})
+	var conflictErr *FieldManagerConflictError
+	assert.Assert(t, errors.As(err, &conflictErr))
+	assert.DeepEqual(t, conflictErr.Conflicts, map[string][]string{
+		"kubectl": {".spec.template.spec.containers[0].image"},
+		"ci":      {".spec.traffic"},
+	})
+	assert.ErrorContains(t, err, "ci:\n    .spec.traffic\n  kubectl:")
+}
+
+func TestApplyServiceServerSideError(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewInternalError(fmt.Errorf("mock internal error"))
+		})
+	_, err := client.ApplyServiceServerSide(context.Background(), newServiceWithImage("my-service", "test/image"), ServerSideApplyOptions{})
+	assert.ErrorType(t, err, apierrors.IsInternalError)
+
+	_, err = client.ApplyServiceServerSide(context.Background(), newService("my-service"), ServerSideApplyOptions{})
+	assert.ErrorContains(t, err, "internal error")
+}
+
+func TestExtractFieldManager(t *testing.T) {
+	for _, tc := range []struct {
+		message string
+		want    string
+	}{
+		{`conflict with "kubectl"`, "kubectl"},
+		{`conflict with "kn" using serving.knative.dev/v1 at 2026-01-01T00:00:00Z`, "kn"},
+		{`conflict with "my \"manager\""`, `my "manager"`},
+		{`something else`, "something else"},
+	} {
+		assert.Equal(t, extractFieldManager(tc.message), tc.want)
+	}
+}
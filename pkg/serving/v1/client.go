@@ -16,7 +16,6 @@ package v1
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -66,7 +65,13 @@ type KnServingClient interface {
 	// List services
 	ListServices(ctx context.Context, opts ...ListConfig) (*servingv1.ServiceList, error)
 
-	// Create a new service
+	// Watch services for changes after the given resource version, falling back to
+	// polling the list if the server doesn't support watching
+	WatchServices(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error)
+
+	// Create a new service. Mutating methods honor a dry-run mode set on the context
+	// with WithDryRun. For DryRunServer the given service is updated to the state as returned
+	// by the API server without persisting it, for DryRunClient no request is sent at all
 	CreateService(ctx context.Context, service *servingv1.Service) error
 
 	// UpdateService updates the given service. For a more robust variant with automatic
@@ -89,6 +94,12 @@ type KnServingClient interface {
 	// An error can indicate a general error or a conflict that occurred during the three way merge.
 	ApplyService(ctx context.Context, service *servingv1.Service) (bool, error)
 
+	// ApplyServiceServerSide applies a service's definition with a Kubernetes server-side apply.
+	// In contrast to ApplyService, the merge is performed by the API server which tracks
+	// the ownership of fields per field manager. If fields owned by other managers would be changed,
+	// a FieldManagerConflictError is returned unless the conflicts are forced.
+	ApplyServiceServerSide(ctx context.Context, service *servingv1.Service, opts ServerSideApplyOptions) (*ServerSideApplyResult, error)
+
 	// Delete a service by name
 	DeleteService(ctx context.Context, name string, timeout time.Duration) error
 
@@ -115,6 +126,9 @@ type KnServingClient interface {
 	// List revisions
 	ListRevisions(ctx context.Context, opts ...ListConfig) (*servingv1.RevisionList, error)
 
+	// Watch revisions for changes after the given resource version
+	WatchRevisions(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error)
+
 	// Delete a revision
 	DeleteRevision(ctx context.Context, name string, timeout time.Duration) error
 
@@ -123,6 +137,9 @@ type KnServingClient interface {
 
 	// List routes
 	ListRoutes(ctx context.Context, opts ...ListConfig) (*servingv1.RouteList, error)
+
+	// Watch routes for changes after the given resource version
+	WatchRoutes(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error)
 }
 
 type listConfigCollector struct {
@@ -238,22 +255,43 @@ func (cl *knServingClient) ListServices(ctx context.Context, config ...ListConfi
 	return serviceListNew, nil
 }
 
+// Watch services
+func (cl *knServingClient) WatchServices(ctx context.Context, resourceVersion string, config ...ListConfig) (watch.Interface, error) {
+	services := cl.client.Services(cl.namespace)
+	return watchServingList(ctx, services.Watch, func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
+		return services.List(ctx, opts)
+	}, resourceVersion, config)
+}
+
 // Create a new service
 func (cl *knServingClient) CreateService(ctx context.Context, service *servingv1.Service) error {
-	_, err := cl.client.Services(cl.namespace).Create(ctx, service, v1.CreateOptions{})
+	if isClientDryRun(ctx) {
+		return updateServingGvk(service)
+	}
+	created, err := cl.client.Services(cl.namespace).Create(ctx, service, v1.CreateOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return clienterrors.GetError(err)
 	}
+	if isServerDryRun(ctx) {
+		created.DeepCopyInto(service)
+	}
 	return updateServingGvk(service)
 }
 
 // Update the given service
 func (cl *knServingClient) UpdateService(ctx context.Context, service *servingv1.Service) (bool, error) {
-	updated, err := cl.client.Services(cl.namespace).Update(ctx, service, v1.UpdateOptions{})
+	if isClientDryRun(ctx) {
+		// Without asking the server it can't be detected whether a new generation would be created
+		return true, updateServingGvk(service)
+	}
+	updated, err := cl.client.Services(cl.namespace).Update(ctx, service, v1.UpdateOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return false, err
 	}
 	changed := service.ObjectMeta.Generation != updated.ObjectMeta.Generation
+	if isServerDryRun(ctx) {
+		updated.DeepCopyInto(service)
+	}
 	return changed, updateServingGvk(service)
 }
 
@@ -294,9 +332,8 @@ func (cl *knServingClient) ApplyService(ctx context.Context, modifiedService *se
 		return false, err
 	}
 
-	containers := modifiedService.Spec.Template.Spec.Containers
-	if len(containers) == 0 || containers[0].Image == "" && currentService != nil {
-		return false, errors.New("'service apply' requires the image name to run provided with the --image option")
+	if err := verifyImageForApply(modifiedService, currentService); err != nil {
+		return false, err
 	}
 
 	// No current service --> create a new service
@@ -324,6 +361,13 @@ func (cl *knServingClient) DeleteService(ctx context.Context, serviceName string
 	if service.GetDeletionTimestamp() != nil {
 		return fmt.Errorf("can't delete service '%s' because it has been already marked for deletion", serviceName)
 	}
+	switch DryRunFrom(ctx) {
+	case DryRunClient:
+		return nil
+	case DryRunServer:
+		// Nothing gets deleted, so there is nothing to wait for
+		return cl.deleteService(ctx, serviceName, v1.DeletePropagationBackground)
+	}
 	if timeout == 0 {
 		return cl.deleteService(ctx, serviceName, v1.DeletePropagationBackground)
 	}
@@ -346,7 +390,7 @@ func (cl *knServingClient) deleteService(ctx context.Context, serviceName string
 	err := cl.client.Services(cl.namespace).Delete(
 		ctx,
 		serviceName,
-		v1.DeleteOptions{PropagationPolicy: &propagationPolicy},
+		v1.DeleteOptions{PropagationPolicy: &propagationPolicy, DryRun: dryRunOption(ctx)},
 	)
 	if err != nil {
 		return clienterrors.GetError(err)
@@ -472,6 +516,13 @@ func (cl *knServingClient) DeleteRevision(ctx context.Context, name string, time
 	if revision.GetDeletionTimestamp() != nil {
 		return fmt.Errorf("can't delete revision '%s' because it has been already marked for deletion", name)
 	}
+	switch DryRunFrom(ctx) {
+	case DryRunClient:
+		return nil
+	case DryRunServer:
+		// Nothing gets deleted, so there is nothing to wait for
+		return cl.deleteRevision(ctx, name)
+	}
 	if timeout == 0 {
 		return cl.deleteRevision(ctx, name)
 	}
@@ -490,7 +541,7 @@ func (cl *knServingClient) DeleteRevision(ctx context.Context, name string, time
 }
 
 func (cl *knServingClient) deleteRevision(ctx context.Context, name string) error {
-	err := cl.client.Revisions(cl.namespace).Delete(ctx, name, v1.DeleteOptions{})
+	err := cl.client.Revisions(cl.namespace).Delete(ctx, name, v1.DeleteOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return clienterrors.GetError(err)
 	}
@@ -507,6 +558,14 @@ func (cl *knServingClient) ListRevisions(ctx context.Context, config ...ListConf
 	return updateServingGvkForRevisionList(revisionList)
 }
 
+// Watch revisions
+func (cl *knServingClient) WatchRevisions(ctx context.Context, resourceVersion string, config ...ListConfig) (watch.Interface, error) {
+	revisions := cl.client.Revisions(cl.namespace)
+	return watchServingList(ctx, revisions.Watch, func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
+		return revisions.List(ctx, opts)
+	}, resourceVersion, config)
+}
+
 // Get a route by its unique name
 func (cl *knServingClient) GetRoute(ctx context.Context, name string) (*servingv1.Route, error) {
 	route, err := cl.client.Routes(cl.namespace).Get(ctx, name, v1.GetOptions{})
@@ -529,6 +588,35 @@ func (cl *knServingClient) ListRoutes(ctx context.Context, config ...ListConfig)
 	return updateServingGvkForRouteList(routeList)
 }
 
+// Watch routes
+func (cl *knServingClient) WatchRoutes(ctx context.Context, resourceVersion string, config ...ListConfig) (watch.Interface, error) {
+	routes := cl.client.Routes(cl.namespace)
+	return watchServingList(ctx, routes.Watch, func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
+		return routes.List(ctx, opts)
+	}, resourceVersion, config)
+}
+
+// watchServingList watches the resources selected by the list configs and updates the
+// watched objects with the GroupVersionKind specific to Knative serving
+func watchServingList(ctx context.Context,
+	watchFunc func(context.Context, v1.ListOptions) (watch.Interface, error),
+	listFunc func(context.Context, v1.ListOptions) (runtime.Object, error),
+	resourceVersion string, config []ListConfig) (watch.Interface, error) {
+	opts := ListConfigs(config).toListOptions()
+	opts.ResourceVersion = resourceVersion
+	watcher, err := wait.NewListWatcherWithVersion(ctx, watchFunc, listFunc, opts)
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+		if event.Type != watch.Error && event.Object != nil {
+			// the kind is only used for printing, so an object without it is still passed on
+			_ = updateServingGvk(event.Object)
+		}
+		return event, true
+	}), nil
+}
+
 // update all the list + all items contained in the list with
 // the proper GroupVersionKind specific to Knative serving
 func updateServingGvkForRevisionList(revisionList *servingv1.RevisionList) (*servingv1.RevisionList, error) {
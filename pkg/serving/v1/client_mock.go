@@ -22,6 +22,7 @@ import (
 	"gotest.tools/v3/assert"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/util/mock"
@@ -80,6 +81,17 @@ func (c *MockKnServingClient) ListServices(ctx context.Context, opts ...ListConf
 	return call.Result[0].(*servingv1.ServiceList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchServices records a call to WatchServices with possible return values
+func (sr *ServingRecorder) WatchServices(resourceVersion, opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchServices", []interface{}{resourceVersion, opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchServices(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchServices", resourceVersion, opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Create a new service
 func (sr *ServingRecorder) CreateService(service interface{}, err error) {
 	sr.r.Add("CreateService", []interface{}{service}, []interface{}{err})
@@ -115,6 +127,16 @@ func (c *MockKnServingClient) ApplyService(ctx context.Context, service *serving
 	return call.Result[0].(bool), mock.ErrorOrNil(call.Result[1])
 }
 
+// Apply the given service server-side
+func (sr *ServingRecorder) ApplyServiceServerSide(service interface{}, opts interface{}, result *ServerSideApplyResult, err error) {
+	sr.r.Add("ApplyServiceServerSide", []interface{}{service, opts}, []interface{}{result, err})
+}
+
+func (c *MockKnServingClient) ApplyServiceServerSide(ctx context.Context, service *servingv1.Service, opts ServerSideApplyOptions) (*ServerSideApplyResult, error) {
+	call := c.recorder.r.VerifyCall("ApplyServiceServerSide", service, opts)
+	return call.Result[0].(*ServerSideApplyResult), mock.ErrorOrNil(call.Result[1])
+}
+
 // Delete a service by name
 func (sr *ServingRecorder) DeleteService(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteService", []interface{}{name, timeout}, []interface{}{err})
@@ -155,6 +177,17 @@ func (c *MockKnServingClient) ListRevisions(ctx context.Context, opts ...ListCon
 	return call.Result[0].(*servingv1.RevisionList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchRevisions records a call to WatchRevisions with possible return values
+func (sr *ServingRecorder) WatchRevisions(resourceVersion, opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchRevisions", []interface{}{resourceVersion, opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchRevisions(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchRevisions", resourceVersion, opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Delete a revision
 func (sr *ServingRecorder) DeleteRevision(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteRevision", []interface{}{name, timeout}, []interface{}{err})
@@ -196,6 +229,17 @@ func (c *MockKnServingClient) ListRoutes(ctx context.Context, opts ...ListConfig
 	return call.Result[0].(*servingv1.RouteList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchRoutes records a call to WatchRoutes with possible return values
+func (sr *ServingRecorder) WatchRoutes(resourceVersion, opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchRoutes", []interface{}{resourceVersion, opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchRoutes(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchRoutes", resourceVersion, opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // GetConfiguration records a call to GetConfiguration with possible return values
 func (sr *ServingRecorder) GetConfiguration(name string, config *servingv1.Configuration, err error) {
 	sr.r.Add("GetConfiguration", []interface{}{name}, []interface{}{config, err})
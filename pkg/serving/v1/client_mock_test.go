@@ -36,9 +36,11 @@ func TestMockKnClient(t *testing.T) {
 	recorder.GetService("hello", nil, nil)
 	recorder.ListServices(mock.Any(), nil, nil)
 	recorder.ListServices(mock.Any(), nil, nil)
+	recorder.WatchServices("1", mock.Any(), nil, nil)
 	recorder.CreateService(&servingv1.Service{}, nil)
 	recorder.UpdateService(&servingv1.Service{}, false, nil)
 	recorder.ApplyService(&servingv1.Service{}, true, nil)
+	recorder.ApplyServiceServerSide(&servingv1.Service{}, ServerSideApplyOptions{}, &ServerSideApplyResult{}, nil)
 	recorder.DeleteService("hello", time.Duration(10)*time.Second, nil)
 	recorder.WaitForService("hello", WaitConfig{
 		Timeout:     time.Duration(10) * time.Second,
@@ -46,12 +48,14 @@ func TestMockKnClient(t *testing.T) {
 	}, wait.NoopMessageCallback(), nil, 10*time.Second)
 	recorder.GetRevision("hello", nil, nil)
 	recorder.ListRevisions(mock.Any(), nil, nil)
+	recorder.WatchRevisions("1", mock.Any(), nil, nil)
 	recorder.CreateRevision(&servingv1.Revision{}, nil)
 	recorder.UpdateRevision(&servingv1.Revision{}, nil)
 	recorder.DeleteRevision("hello", time.Duration(10)*time.Second, nil)
 	recorder.WaitForRevision("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback(), nil, 10*time.Second)
 	recorder.GetRoute("hello", nil, nil)
 	recorder.ListRoutes(mock.Any(), nil, nil)
+	recorder.WatchRoutes("1", mock.Any(), nil, nil)
 	recorder.GetConfiguration("hello", nil, nil)
 
 	// Call all services
@@ -59,9 +63,11 @@ func TestMockKnClient(t *testing.T) {
 	client.GetService(ctx, "hello")
 	client.ListServices(ctx, WithName("blub"))
 	client.ListServices(ctx, WithLabel("foo", "bar"))
+	client.WatchServices(ctx, "1")
 	client.CreateService(ctx, &servingv1.Service{})
 	client.UpdateService(ctx, &servingv1.Service{})
 	client.ApplyService(ctx, &servingv1.Service{})
+	client.ApplyServiceServerSide(ctx, &servingv1.Service{}, ServerSideApplyOptions{})
 	client.DeleteService(ctx, "hello", time.Duration(10)*time.Second)
 	client.WaitForService(ctx, "hello", WaitConfig{
 		time.Duration(10) * time.Second,
@@ -69,12 +75,14 @@ func TestMockKnClient(t *testing.T) {
 	}, wait.NoopMessageCallback())
 	client.GetRevision(ctx, "hello")
 	client.ListRevisions(ctx, WithName("blub"))
+	client.WatchRevisions(ctx, "1", WithService("blub"))
 	client.CreateRevision(ctx, &servingv1.Revision{})
 	client.UpdateRevision(ctx, &servingv1.Revision{})
 	client.DeleteRevision(ctx, "hello", time.Duration(10)*time.Second)
 	client.WaitForRevision(ctx, "hello", time.Duration(10)*time.Second, wait.NoopMessageCallback())
 	client.GetRoute(ctx, "hello")
 	client.ListRoutes(ctx, WithName("blub"))
+	client.WatchRoutes(ctx, "1")
 	client.GetConfiguration(ctx, "hello")
 
 	// Validate
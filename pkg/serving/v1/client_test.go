@@ -151,6 +151,28 @@ func TestListServiceError(t *testing.T) {
 	assert.Assert(t, listServices == nil)
 }
 
+func TestWatchServices(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddWatchReactor("services",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			restrictions := a.(clienttesting.WatchAction).GetWatchRestrictions()
+			assert.Equal(t, restrictions.ResourceVersion, "42")
+			assert.Equal(t, restrictions.Labels.String(), "foo=bar")
+			w := wait.NewFakeWatch([]watch.Event{{Type: watch.Added, Object: &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}}})
+			w.Start()
+			return true, w, nil
+		})
+
+	watcher, err := client.WatchServices(context.Background(), "42", WithLabel("foo", "bar"))
+	assert.NilError(t, err)
+	defer watcher.Stop()
+	event := <-watcher.ResultChan()
+	assert.Equal(t, event.Type, watch.Added)
+	assert.Equal(t, event.Object.(*servingv1.Service).Name, "foo")
+	assert.Equal(t, event.Object.GetObjectKind().GroupVersionKind(), servingv1.SchemeGroupVersion.WithKind("Service"))
+}
+
 func TestCreateService(t *testing.T) {
 	serving, client := setup()
 
@@ -0,0 +1,70 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DryRunMode selects whether mutating operations are persisted
+type DryRunMode string
+
+const (
+	// DryRunNone persists all changes (default)
+	DryRunNone DryRunMode = ""
+
+	// DryRunClient skips all mutating requests, so that objects are only
+	// prepared on the client side without being sent to the API server
+	DryRunClient DryRunMode = "client"
+
+	// DryRunServer sends requests to the API server with the dry-run option, so that
+	// they are fully validated (including admission webhooks) but not persisted
+	DryRunServer DryRunMode = "server"
+)
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context which makes the serving client perform
+// mutating operations in the given dry-run mode
+func WithDryRun(ctx context.Context, mode DryRunMode) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, mode)
+}
+
+// DryRunFrom returns the dry-run mode stored in the context
+func DryRunFrom(ctx context.Context) DryRunMode {
+	mode, ok := ctx.Value(dryRunKey{}).(DryRunMode)
+	if !ok {
+		return DryRunNone
+	}
+	return mode
+}
+
+func isServerDryRun(ctx context.Context) bool {
+	return DryRunFrom(ctx) == DryRunServer
+}
+
+func isClientDryRun(ctx context.Context) bool {
+	return DryRunFrom(ctx) == DryRunClient
+}
+
+// dryRunOption returns the dry-run value for the API server's create, update, patch and delete options
+func dryRunOption(ctx context.Context) []string {
+	if isServerDryRun(ctx) {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
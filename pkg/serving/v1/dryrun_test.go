@@ -0,0 +1,186 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestDryRunFrom(t *testing.T) {
+	assert.Equal(t, DryRunFrom(context.Background()), DryRunNone)
+	assert.Equal(t, DryRunFrom(WithDryRun(context.Background(), DryRunServer)), DryRunServer)
+	assert.Equal(t, DryRunFrom(WithDryRun(context.Background(), DryRunClient)), DryRunClient)
+}
+
+func TestServerDryRunCreateAndUpdate(t *testing.T) {
+	serving, client := setup()
+	ctx := WithDryRun(context.Background(), DryRunServer)
+
+	serving.AddReactor("create", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.DeepEqual(t, a.(clienttesting.CreateActionImpl).CreateOptions.DryRun, []string{metav1.DryRunAll})
+			created := newServiceWithImage("foo", "test/image")
+			created.Generation = 1
+			return true, created, nil
+		})
+	serving.AddReactor("update", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.DeepEqual(t, a.(clienttesting.UpdateActionImpl).UpdateOptions.DryRun, []string{metav1.DryRunAll})
+			updated := newServiceWithImage("foo", "test/new-image")
+			updated.Generation = 2
+			return true, updated, nil
+		})
+
+	service := newServiceWithImage("foo", "test/image")
+	assert.NilError(t, client.CreateService(ctx, service))
+	assert.Equal(t, service.Generation, int64(1))
+
+	changed, err := client.UpdateService(ctx, service)
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+	assert.Equal(t, service.Generation, int64(2))
+	assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "test/new-image")
+}
+
+func TestClientDryRunCreateAndUpdate(t *testing.T) {
+	serving, client := setup()
+	ctx := WithDryRun(context.Background(), DryRunClient)
+
+	service := newServiceWithImage("foo", "test/image")
+	assert.NilError(t, client.CreateService(ctx, service))
+	assert.Equal(t, service.Kind, "Service")
+
+	changed, err := client.UpdateService(ctx, service)
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+	assert.Equal(t, len(serving.Actions()), 0)
+}
+
+func TestDryRunDelete(t *testing.T) {
+	serving, client := setup()
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, newService("foo"), nil
+		})
+	serving.AddReactor("delete", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.DeepEqual(t, a.(clienttesting.DeleteActionImpl).DeleteOptions.DryRun, []string{metav1.DryRunAll})
+			return true, nil, nil
+		})
+
+	assert.NilError(t, client.DeleteService(WithDryRun(context.Background(), DryRunClient), "foo", time.Minute))
+	assert.Equal(t, countActions(serving.Actions(), "delete"), 0)
+
+	// Must not wait for a deletion, which never happens
+	assert.NilError(t, client.DeleteService(WithDryRun(context.Background(), DryRunServer), "foo", time.Minute))
+	assert.Equal(t, countActions(serving.Actions(), "delete"), 1)
+	assert.Equal(t, countActions(serving.Actions(), "watch"), 0)
+}
+
+func TestDryRunDeleteRevision(t *testing.T) {
+	serving, client := setup()
+	serving.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: testNamespace}}, nil
+		})
+	serving.AddReactor("delete", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.DeepEqual(t, a.(clienttesting.DeleteActionImpl).DeleteOptions.DryRun, []string{metav1.DryRunAll})
+			return true, nil, nil
+		})
+
+	assert.NilError(t, client.DeleteRevision(WithDryRun(context.Background(), DryRunClient), "foo-00001", time.Minute))
+	assert.Equal(t, countActions(serving.Actions(), "delete"), 0)
+
+	assert.NilError(t, client.DeleteRevision(WithDryRun(context.Background(), DryRunServer), "foo-00001", time.Minute))
+	assert.Equal(t, countActions(serving.Actions(), "delete"), 1)
+	assert.Equal(t, countActions(serving.Actions(), "watch"), 0)
+}
+
+func TestClientDryRunApply(t *testing.T) {
+	serving, client := setup()
+	current := newServiceWithImage("foo", "test/image")
+	current.Annotations = map[string]string{"foo": "bar"}
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, current, nil
+		})
+
+	service := newServiceWithImage("foo", "test/new-image")
+	changed, err := client.ApplyService(WithDryRun(context.Background(), DryRunClient), service)
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+	assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "test/new-image")
+	assert.Equal(t, service.Annotations["foo"], "bar")
+	assert.Equal(t, countActions(serving.Actions(), "patch"), 0)
+}
+
+func TestServerDryRunApplyServerSide(t *testing.T) {
+	serving, client := setup()
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, newServiceWithImage("foo", "test/image"), nil
+		})
+	serving.AddReactor("patch", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.DeepEqual(t, a.(clienttesting.PatchActionImpl).PatchOptions.DryRun, []string{metav1.DryRunAll})
+			applied := newServiceWithImage("foo", "test/new-image")
+			applied.Generation = 2
+			return true, applied, nil
+		})
+
+	service := newServiceWithImage("foo", "test/new-image")
+	_, err := client.ApplyServiceServerSide(WithDryRun(context.Background(), DryRunServer), service, ServerSideApplyOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, service.Generation, int64(2))
+
+	_, err = client.ApplyServiceServerSide(WithDryRun(context.Background(), DryRunClient), service, ServerSideApplyOptions{})
+	assert.ErrorContains(t, err, "client dry-run")
+}
+
+func TestGitOpsDryRun(t *testing.T) {
+	tempDir := t.TempDir()
+	client := NewKnServingGitOpsClient("default", tempDir)
+	ctx := WithDryRun(context.Background(), DryRunClient)
+
+	assert.NilError(t, client.CreateService(ctx, newServiceWithImage("foo", "test/image")))
+	_, err := os.Stat(filepath.Join(tempDir, "default", "ksvc", "foo.yaml"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	assert.NilError(t, client.CreateService(context.Background(), newServiceWithImage("foo", "test/image")))
+	assert.NilError(t, client.DeleteService(ctx, "foo", 0))
+	_, err = os.Stat(filepath.Join(tempDir, "default", "ksvc", "foo.yaml"))
+	assert.NilError(t, err)
+}
+
+func countActions(actions []clienttesting.Action, verb string) int {
+	count := 0
+	for _, action := range actions {
+		if action.GetVerb() == verb {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,342 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/serving/pkg/apis/serving"
+)
+
+// problemReasons are reasons of events which hint at a revision which can't become ready,
+// even if they aren't reported as warnings
+var problemReasons = sets.New("BackOff", "ErrImagePull", "ImagePullBackOff", "Failed", "FailedCreate",
+	"FailedMount", "FailedScheduling", "OOMKilled", "OOMKilling", "Unhealthy", "InternalError")
+
+// Event is a Kubernetes event of a service or of an object owned by it. Repeated events
+// are aggregated into a single one.
+type Event struct {
+	// Type is either Normal or Warning
+	Type string
+	// Reason is the short reason of the event, like BackOff
+	Reason string
+	// Message is the human readable description of the event
+	Message string
+	// Object is the kind and name of the object the event is about, like Pod/foo-00001-deployment-5b8c
+	Object string
+	// Count is the number of times the event has been seen
+	Count int32
+	// FirstSeen is when the event has been seen for the first time
+	FirstSeen time.Time
+	// LastSeen is when the event has been seen for the last time
+	LastSeen time.Time
+}
+
+// IsProblem returns whether the event is a warning or is known to hint at a problem,
+// like failed probes or image pulls
+func (e Event) IsProblem() bool {
+	return e.Type == corev1.EventTypeWarning || problemReasons.Has(e.Reason)
+}
+
+// EventCollector collects the events of a service and of all objects owned by it:
+// its configuration and route, the revisions, and their deployments, replica sets and pods
+type EventCollector struct {
+	client  KnServingClient
+	kube    kubernetes.Interface
+	service string
+
+	// objects are the keys of the owned objects, see objectKey
+	objects         sets.Set[string]
+	unrelated       sets.Set[string]
+	resourceVersion string
+}
+
+// NewEventCollector creates a collector for the events of the given service
+func NewEventCollector(client KnServingClient, kube kubernetes.Interface, service string) *EventCollector {
+	return &EventCollector{client: client, kube: kube, service: service, unrelated: sets.New[string]()}
+}
+
+// List returns the events of the service sorted by the time they have been seen last,
+// with repeated events aggregated. Containers which have been killed for running out of
+// memory are reported as events, too, as Kubernetes only records them in the pod status.
+func (c *EventCollector) List(ctx context.Context) ([]Event, error) {
+	pods, err := c.resolveObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eventList, err := c.kube.CoreV1().Events(c.client.Namespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.resourceVersion = eventList.ResourceVersion
+	var events []Event
+	for i := range eventList.Items {
+		if event := &eventList.Items[i]; c.objects.Has(involvedObjectKey(event)) {
+			events = append(events, toEvent(event))
+		}
+	}
+	for _, pod := range pods {
+		events = append(events, oomKilledEvents(pod)...)
+	}
+	return aggregateEvents(events), nil
+}
+
+// Watch calls the handler for each event of the service which is added or updated after
+// the last call of List, until the context is cancelled. Objects created after the
+// watch started, like the pods of a new revision, are picked up as well. As their names
+// aren't known up front, all events of the namespace are watched and filtered here.
+func (c *EventCollector) Watch(ctx context.Context, handler func(Event)) error {
+	if c.objects == nil {
+		if _, err := c.resolveObjects(ctx); err != nil {
+			return err
+		}
+	}
+	watcher, err := c.kube.CoreV1().Events(c.client.Namespace()).Watch(ctx, metav1.ListOptions{ResourceVersion: c.resourceVersion})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case watchEvent, ok := <-watcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("watch for events of service '%s' closed unexpectedly", c.service)
+			}
+			event, isEvent := watchEvent.Object.(*corev1.Event)
+			if !isEvent || (watchEvent.Type != watch.Added && watchEvent.Type != watch.Modified) {
+				continue
+			}
+			belongs, err := c.belongs(ctx, event)
+			if err != nil {
+				return err
+			}
+			if belongs {
+				handler(toEvent(event))
+			}
+		}
+	}
+}
+
+// belongs returns whether the event is about an object owned by the service. The owned
+// objects are resolved again for unknown objects which might have been created meanwhile,
+// as all of them are named after the service. Objects which still aren't owned by the
+// service are remembered, so that they don't trigger resolving again.
+func (c *EventCollector) belongs(ctx context.Context, event *corev1.Event) (bool, error) {
+	object := involvedObjectKey(event)
+	if c.objects.Has(object) {
+		return true, nil
+	}
+	if c.unrelated.Has(object) || !strings.HasPrefix(event.InvolvedObject.Name, c.service) {
+		return false, nil
+	}
+	if _, err := c.resolveObjects(ctx); err != nil {
+		return false, err
+	}
+	if !c.objects.Has(object) {
+		c.unrelated.Insert(object)
+		return false, nil
+	}
+	return true, nil
+}
+
+// resolveObjects follows the owner references from the revisions of the service down to
+// their pods, which are returned
+func (c *EventCollector) resolveObjects(ctx context.Context) ([]*corev1.Pod, error) {
+	objects := sets.New(
+		objectKey(serving.GroupName, "Service", c.service),
+		objectKey(serving.GroupName, "Configuration", c.service),
+		objectKey(serving.GroupName, "Route", c.service))
+
+	revisions, err := c.client.ListRevisions(ctx, WithService(c.service))
+	if err != nil {
+		return nil, err
+	}
+	owners := sets.New[types.UID]()
+	for _, revision := range revisions.Items {
+		objects.Insert(objectKey(serving.GroupName, "Revision", revision.Name))
+		owners.Insert(revision.UID)
+	}
+
+	namespace := c.client.Namespace()
+	selector := metav1.ListOptions{LabelSelector: labels.Set{serving.ServiceLabelKey: c.service}.String()}
+	deployments, err := c.kube.AppsV1().Deployments(namespace).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	var metas []*metav1.ObjectMeta
+	for i := range deployments.Items {
+		metas = append(metas, &deployments.Items[i].ObjectMeta)
+	}
+	owners = ownedBy(objects, appsv1.GroupName, "Deployment", owners, metas)
+	replicaSets, err := c.kube.AppsV1().ReplicaSets(namespace).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for i := range replicaSets.Items {
+		metas = append(metas, &replicaSets.Items[i].ObjectMeta)
+	}
+	owners = ownedBy(objects, appsv1.GroupName, "ReplicaSet", owners, metas)
+	podList, err := c.kube.CoreV1().Pods(namespace).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	var pods []*corev1.Pod
+	for i := range podList.Items {
+		if pod := &podList.Items[i]; isOwnedBy(&pod.ObjectMeta, owners) {
+			objects.Insert(objectKey(corev1.GroupName, "Pod", pod.Name))
+			pods = append(pods, pod)
+		}
+	}
+
+	c.objects = objects
+	return pods, nil
+}
+
+// ownedBy adds the objects of the given group and kind owned by one of the owners, and returns
+// their UIDs as the owners of the next level
+func ownedBy(objects sets.Set[string], group, kind string, owners sets.Set[types.UID], metas []*metav1.ObjectMeta) sets.Set[types.UID] {
+	owned := sets.New[types.UID]()
+	for _, meta := range metas {
+		if isOwnedBy(meta, owners) {
+			objects.Insert(objectKey(group, kind, meta.Name))
+			owned.Insert(meta.UID)
+		}
+	}
+	return owned
+}
+
+func isOwnedBy(meta *metav1.ObjectMeta, owners sets.Set[types.UID]) bool {
+	for _, ref := range meta.OwnerReferences {
+		if owners.Has(ref.UID) {
+			return true
+		}
+	}
+	return false
+}
+
+func involvedObject(event *corev1.Event) string {
+	return event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+}
+
+// objectKey identifies an object by its API group, kind and name. The group tells the
+// Knative service apart from the Kubernetes service of the same name created for its route.
+func objectKey(group, kind, name string) string {
+	return group + "/" + kind + "/" + name
+}
+
+func involvedObjectKey(event *corev1.Event) string {
+	gv, _ := schema.ParseGroupVersion(event.InvolvedObject.APIVersion)
+	return objectKey(gv.Group, event.InvolvedObject.Kind, event.InvolvedObject.Name)
+}
+
+func toEvent(event *corev1.Event) Event {
+	result := Event{
+		Type:      event.Type,
+		Reason:    event.Reason,
+		Message:   strings.TrimSpace(event.Message),
+		Object:    involvedObject(event),
+		Count:     event.Count,
+		FirstSeen: event.FirstTimestamp.Time,
+		LastSeen:  event.LastTimestamp.Time,
+	}
+	// events created with the events.k8s.io API only have the event time and a series
+	if result.FirstSeen.IsZero() {
+		result.FirstSeen = event.EventTime.Time
+	}
+	if result.FirstSeen.IsZero() {
+		result.FirstSeen = event.CreationTimestamp.Time
+	}
+	if event.Series != nil {
+		result.Count = event.Series.Count
+		result.LastSeen = event.Series.LastObservedTime.Time
+	}
+	if result.LastSeen.IsZero() {
+		result.LastSeen = result.FirstSeen
+	}
+	if result.Count < 1 {
+		result.Count = 1
+	}
+	return result
+}
+
+// IsOOMKilled returns whether the container has been killed in the given state as it ran out of memory
+func IsOOMKilled(state corev1.ContainerState) bool {
+	return state.Terminated != nil && state.Terminated.Reason == "OOMKilled"
+}
+
+func oomKilledEvents(pod *corev1.Pod) []Event {
+	var events []Event
+	for _, status := range pod.Status.ContainerStatuses {
+		for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+			if !IsOOMKilled(state) {
+				continue
+			}
+			events = append(events, Event{
+				Type:      corev1.EventTypeWarning,
+				Reason:    "OOMKilled",
+				Message:   fmt.Sprintf("Container %s has been killed as it ran out of memory", status.Name),
+				Object:    "Pod/" + pod.Name,
+				Count:     1,
+				FirstSeen: state.Terminated.FinishedAt.Time,
+				LastSeen:  state.Terminated.FinishedAt.Time,
+			})
+		}
+	}
+	return events
+}
+
+// aggregateEvents merges events with the same object, type, reason and message and
+// sorts them by the time they have been seen last
+func aggregateEvents(events []Event) []Event {
+	var aggregated []Event
+	index := map[string]int{}
+	for _, event := range events {
+		key := strings.Join([]string{event.Object, event.Type, event.Reason, event.Message}, "\x00")
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(aggregated)
+			aggregated = append(aggregated, event)
+			continue
+		}
+		merged := &aggregated[i]
+		merged.Count += event.Count
+		if event.FirstSeen.Before(merged.FirstSeen) {
+			merged.FirstSeen = event.FirstSeen
+		}
+		if event.LastSeen.After(merged.LastSeen) {
+			merged.LastSeen = event.LastSeen
+		}
+	}
+	sort.SliceStable(aggregated, func(i, j int) bool {
+		return aggregated[i].LastSeen.Before(aggregated[j].LastSeen)
+	})
+	return aggregated
+}
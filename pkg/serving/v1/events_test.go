@@ -0,0 +1,194 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestEventCollectorList(t *testing.T) {
+	now := time.Now()
+	objects := ownedObjects("foo-00001")
+	oomPod := objects[2].(*corev1.Pod)
+	oomPod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "user-container",
+		LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			Reason: "OOMKilled", FinishedAt: metav1.NewTime(now.Add(-time.Minute)),
+		}},
+	}}
+	orphan := newOwnedPod("foo-00001-deployment-orphan", "unknown")
+	objects = append(objects, orphan,
+		newEvent("e1", "Service", "foo", corev1.EventTypeNormal, "Created", "Created service", now.Add(-10*time.Minute), 1),
+		newEvent("e2", "Pod", oomPod.Name, corev1.EventTypeWarning, "BackOff", "Back-off pulling image", now.Add(-5*time.Minute), 3),
+		newEvent("e3", "Pod", oomPod.Name, corev1.EventTypeWarning, "BackOff", "Back-off pulling image", now.Add(-2*time.Minute), 2),
+		newEvent("e4", "Revision", "foo-00001", corev1.EventTypeNormal, "Ready", "Revision is ready", now.Add(-3*time.Minute), 1),
+		newEvent("e5", "Pod", orphan.Name, corev1.EventTypeNormal, "Pulled", "Pulled image", now, 1),
+		newEvent("e6", "Service", "bar", corev1.EventTypeNormal, "Created", "Created service", now, 1),
+	)
+	series := newEvent("e7", "ReplicaSet", "foo-00001-deployment-1", corev1.EventTypeNormal, "SuccessfulCreate", "Created pod", time.Time{}, 0)
+	series.EventTime = metav1.NewMicroTime(now.Add(-20 * time.Minute))
+	series.Series = &corev1.EventSeries{Count: 4, LastObservedTime: metav1.NewMicroTime(now.Add(-30 * time.Second))}
+	// the Kubernetes service created for the route has the same name
+	coreService := newEvent("e8", "Service", "foo", corev1.EventTypeNormal, "Synced", "Kubernetes service", now, 1)
+	coreService.InvolvedObject.APIVersion = "v1"
+	objects = append(objects, series, coreService)
+	kubeClient := fake.NewSimpleClientset(objects...)
+
+	client := NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{*newOwnerRevision("foo-00001")}}, nil)
+
+	events, err := NewEventCollector(client, kubeClient, "foo").List(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, len(events), 5)
+
+	assert.Equal(t, events[0].Object, "Service/foo")
+	assert.Equal(t, events[1].Object, "Revision/foo-00001")
+	assert.Equal(t, events[2].Object, "Pod/"+oomPod.Name)
+	assert.Equal(t, events[2].Reason, "BackOff")
+	assert.Equal(t, events[2].Count, int32(5))
+	assert.Assert(t, events[2].FirstSeen.Equal(now.Add(-5*time.Minute)))
+	assert.Assert(t, events[2].IsProblem())
+	assert.Equal(t, events[3].Reason, "OOMKilled")
+	assert.Assert(t, events[3].IsProblem())
+	assert.Equal(t, events[4].Object, "ReplicaSet/foo-00001-deployment-1")
+	assert.Equal(t, events[4].Count, int32(4))
+	assert.Assert(t, !events[4].IsProblem())
+	lists := 0
+	for _, action := range kubeClient.Actions() {
+		if action.Matches("list", "events") {
+			lists++
+		}
+	}
+	assert.Equal(t, lists, 1)
+
+	r.Validate()
+}
+
+func TestEventCollectorWatch(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(ownedObjects("foo-00001")...)
+	client := NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{*newOwnerRevision("foo-00001")}}, nil)
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{*newOwnerRevision("foo-00001"), *newOwnerRevision("foo-00002")}}, nil)
+
+	collector := NewEventCollector(client, kubeClient, "foo")
+	_, err := collector.List(context.Background())
+	assert.NilError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan Event, 10)
+	done := make(chan error)
+	go func() {
+		done <- collector.Watch(ctx, func(event Event) { received <- event })
+	}()
+
+	// the watcher might not be started yet, so retry until an event is received
+	events := kubeClient.CoreV1().Events("default")
+	var event Event
+	for i := 0; ; i++ {
+		_, err := events.Create(ctx, newEvent("u"+string(rune('a'+i)), "Service", "bar", corev1.EventTypeNormal, "Created", "unrelated", time.Now(), 1), metav1.CreateOptions{})
+		assert.NilError(t, err)
+		_, err = events.Create(ctx, newEvent("r"+string(rune('a'+i)), "Revision", "foo-00001", corev1.EventTypeNormal, "Ready", "ready", time.Now(), 1), metav1.CreateOptions{})
+		assert.NilError(t, err)
+		select {
+		case event = <-received:
+		case <-time.After(50 * time.Millisecond):
+			assert.Assert(t, i < 100, "no event received")
+			continue
+		}
+		break
+	}
+	assert.Equal(t, event.Object, "Revision/foo-00001")
+
+	// objects of a new revision are resolved when their events arrive
+	for _, object := range ownedObjects("foo-00002") {
+		assert.NilError(t, kubeClient.Tracker().Add(object))
+	}
+	_, err = events.Create(ctx, newEvent("p", "Pod", "foo-00002-deployment-1-pod", corev1.EventTypeWarning, "Unhealthy", "Readiness probe failed", time.Now(), 1), metav1.CreateOptions{})
+	assert.NilError(t, err)
+	for event = range received {
+		if event.Reason == "Unhealthy" {
+			break
+		}
+	}
+	assert.Equal(t, event.Object, "Pod/foo-00002-deployment-1-pod")
+
+	cancel()
+	assert.NilError(t, <-done)
+	r.Validate()
+}
+
+// ownedObjects returns the deployment, replica set and pod of a revision, each owned by the previous one
+func ownedObjects(revision string) []runtime.Object {
+	deployment := &appsv1.Deployment{ObjectMeta: ownedMeta(revision+"-deployment", revision)}
+	replicaSet := &appsv1.ReplicaSet{ObjectMeta: ownedMeta(revision+"-deployment-1", revision+"-deployment")}
+	pod := newOwnedPod(revision+"-deployment-1-pod", revision+"-deployment-1")
+	return []runtime.Object{deployment, replicaSet, pod}
+}
+
+func newOwnedPod(name, owner string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: ownedMeta(name, owner)}
+}
+
+func ownedMeta(name, owner string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       "default",
+		UID:             types.UID(name),
+		Labels:          map[string]string{serving.ServiceLabelKey: "foo"},
+		OwnerReferences: []metav1.OwnerReference{{Name: owner, UID: types.UID(owner)}},
+	}
+}
+
+func newOwnerRevision(name string) *servingv1.Revision {
+	return &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name)}}
+}
+
+// apiVersions are the API versions of the kinds of objects events are created for
+var apiVersions = map[string]string{
+	"Service":    "serving.knative.dev/v1",
+	"Revision":   "serving.knative.dev/v1",
+	"ReplicaSet": "apps/v1",
+	"Pod":        "v1",
+}
+
+func newEvent(name, kind, object, eventType, reason, message string, seen time.Time, count int32) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{APIVersion: apiVersions[kind], Kind: kind, Name: object, Namespace: "default"},
+		Type:           eventType,
+		Reason:         reason,
+		Message:        message,
+		Count:          count,
+		FirstTimestamp: metav1.NewTime(seen),
+		LastTimestamp:  metav1.NewTime(seen),
+	}
+}
@@ -16,18 +16,14 @@ package v1
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"os"
-	"path/filepath"
-	"strings"
 	"time"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/yaml"
-	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/apimachinery/pkg/watch"
 
+	"knative.dev/client/pkg/gitops"
 	"knative.dev/client/pkg/wait"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
@@ -39,57 +35,47 @@ const (
 // knServingGitOpsClient - kn service client
 // to work on a local repo instead of a remote cluster
 type knServingGitOpsClient struct {
-	dir        string
-	namespace  string
-	fileMode   bool
-	fileFormat string
+	dir      string
+	services *gitops.Store[servingv1.Service, *servingv1.Service]
 	KnServingClient
 }
 
 // NewKnServingGitOpsClient returns an instance of the
 // kn service gitops client
 func NewKnServingGitOpsClient(namespace, dir string) KnServingClient {
-	mode, format := getFileModeAndType(dir)
 	return &knServingGitOpsClient{
-		dir:        dir,
-		namespace:  namespace,
-		fileMode:   mode,
-		fileFormat: format,
+		dir:      dir,
+		services: gitops.NewStore[servingv1.Service](namespace, dir, ksvcKind, servingv1.Resource("services"), updateServingGvk),
 	}
 }
 
 func (cl *knServingGitOpsClient) getKsvcFilePath(name string) string {
-	if cl.fileMode {
-		return cl.dir
-	}
-	return filepath.Join(cl.dir, cl.namespace, ksvcKind, name+".yaml")
-}
-
-func getFileModeAndType(dir string) (bool, string) {
-	switch {
-	case strings.HasSuffix(dir, ".yaml"):
-		return true, "yaml"
-	case strings.HasSuffix(dir, ".yml"):
-		return true, "yaml"
-	case strings.HasSuffix(dir, ".json"):
-		return true, "json"
-	}
-	return false, "yaml"
+	return cl.services.FilePath(name)
 }
 
 // Namespace returns the namespace
 func (cl *knServingGitOpsClient) Namespace() string {
-	return cl.namespace
+	return cl.services.Namespace()
+}
+
+// WatchServices isn't supported for services stored in files
+func (cl *knServingGitOpsClient) WatchServices(ctx context.Context, resourceVersion string, opts ...ListConfig) (watch.Interface, error) {
+	return nil, errors.New("watching services is not supported for services stored in files, use a cluster instead")
 }
 
 // GetService returns the knative service for the name
 func (cl *knServingGitOpsClient) GetService(ctx context.Context, name string) (*servingv1.Service, error) {
-	return readServiceFromFile(cl.getKsvcFilePath(name), name)
+	return cl.services.Get(name)
 }
 
 // ListServices lists the services in the path provided
 func (cl *knServingGitOpsClient) ListServices(ctx context.Context, opts ...ListConfig) (*servingv1.ServiceList, error) {
-	svcs, err := cl.listServicesFromDirectory()
+	if fileMode, _ := gitops.FileModeAndFormat(cl.dir); !fileMode {
+		if _, err := os.Stat(cl.dir); err != nil {
+			return nil, err
+		}
+	}
+	svcs, err := cl.services.List()
 	if err != nil {
 		return nil, err
 	}
@@ -104,77 +90,15 @@ func (cl *knServingGitOpsClient) ListServices(ctx context.Context, opts ...ListC
 	return serviceList, nil
 }
 
-func (cl *knServingGitOpsClient) listServicesFromDirectory() ([]servingv1.Service, error) {
-	if cl.fileMode {
-		svc, err := readServiceFromFile(cl.dir, "")
-		if err != nil {
-			return nil, err
-		}
-		return []servingv1.Service{*svc}, nil
-	}
-	var services []servingv1.Service
-	root := cl.dir
-	if cl.namespace != "" {
-		root = filepath.Join(cl.dir, cl.namespace)
-	}
-	if _, err := os.Stat(root); err != nil {
-		return nil, err
-	}
-	if err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
-		switch {
-		// skip if dir is not ksvc
-		case info.IsDir():
-			return nil
-
-		// skip non yaml files
-		case !strings.HasSuffix(info.Name(), ".yaml"):
-			return nil
-
-		// skip non ksvc dir
-		case !strings.Contains(path, ksvcKind):
-			return filepath.SkipDir
-
-		default:
-			svc, err := readServiceFromFile(path, "")
-			if err != nil {
-				return err
-			}
-			services = append(services, *svc)
-			return nil
-		}
-	}); err != nil {
-		return nil, err
-	}
-	return services, nil
-}
-
 // CreateService saves the knative service spec in
-// yaml format in the local path provided
+// yaml format in the local path provided. Nothing
+// is written when running in a dry-run mode
 func (cl *knServingGitOpsClient) CreateService(ctx context.Context, service *servingv1.Service) error {
 	updateServingGvk(service)
-	if cl.fileMode {
-		return writeFile(service, cl.dir, cl.fileFormat)
+	if DryRunFrom(ctx) != DryRunNone {
+		return nil
 	}
-	//check if dir exist
-	if _, err := os.Stat(cl.dir); os.IsNotExist(err) {
-		return fmt.Errorf("directory '%s' not present, please create the directory and try again", cl.dir)
-	}
-	return writeFile(service, cl.getKsvcFilePath(service.ObjectMeta.Name), cl.fileFormat)
-}
-
-func writeFile(obj runtime.Object, fp, format string) error {
-	if _, err := os.Stat(fp); os.IsNotExist(err) {
-		os.MkdirAll(filepath.Dir(fp), 0755)
-	}
-	w, err := os.Create(fp)
-	if err != nil {
-		return err
-	}
-	yamlPrinter, err := genericclioptions.NewJSONYamlPrintFlags().ToPrinter(format)
-	if err != nil {
-		return err
-	}
-	return yamlPrinter.PrintObj(obj, w)
+	return cl.services.Write(service)
 }
 
 // UpdateService updates the service in
@@ -193,28 +117,16 @@ func (cl *knServingGitOpsClient) UpdateServiceWithRetry(ctx context.Context, nam
 	return updateServiceWithRetry(ctx, cl, name, updateFunc, nrRetries)
 }
 
-// DeleteService removes the file from the local file system
+// DeleteService removes the service from the local file system
 func (cl *knServingGitOpsClient) DeleteService(ctx context.Context, serviceName string, timeout time.Duration) error {
-	return os.Remove(cl.getKsvcFilePath(serviceName))
+	if DryRunFrom(ctx) != DryRunNone {
+		_, err := cl.GetService(ctx, serviceName)
+		return err
+	}
+	return cl.services.Delete(serviceName)
 }
 
 // WaitForService always returns success for this client
 func (cl *knServingGitOpsClient) WaitForService(ctx context.Context, name string, wconfig WaitConfig, msgCallback wait.MessageCallback) (error, time.Duration) {
 	return nil, 1 * time.Second
 }
-
-func readServiceFromFile(fileKey, name string) (*servingv1.Service, error) {
-	var svc servingv1.Service
-	file, err := os.Open(fileKey)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, apierrors.NewNotFound(servingv1.Resource("services"), name)
-		}
-		return nil, err
-	}
-	decoder := yaml.NewYAMLOrJSONDecoder(file, 512)
-	if err := decoder.Decode(&svc); err != nil {
-		return nil, err
-	}
-	return &svc, nil
-}
@@ -16,7 +16,9 @@ package v1
 
 import (
 	"context"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -233,6 +235,29 @@ func TestGitOpsSingleFile(t *testing.T) {
 	})
 }
 
+func TestGitOpsMultiDocumentFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "app.yaml")
+	other := "# shared config\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n"
+	assert.NilError(t, os.WriteFile(file, []byte(other), 0644))
+	client := NewKnServingGitOpsClient("", file)
+
+	fooSvc := test.BuildServiceWithOptions("foo", servingtest.WithConfigSpec(buildConfiguration()))
+	assert.NilError(t, client.CreateService(context.Background(), fooSvc))
+
+	result, err := client.GetService(context.Background(), "foo")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, fooSvc, result)
+
+	content, err := os.ReadFile(file)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(string(content), other+"---\n"))
+
+	assert.NilError(t, client.DeleteService(context.Background(), "foo", 5*time.Second))
+	content, err = os.ReadFile(file)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), other)
+}
+
 func getServiceList(services []servingv1.Service) *servingv1.ServiceList {
 	return &servingv1.ServiceList{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,240 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+)
+
+// QueueProxyContainerName is the name of the sidecar container injected by Knative serving
+const QueueProxyContainerName = "queue-proxy"
+
+// NoReadyPodError is returned if a revision doesn't have any ready pod
+type NoReadyPodError struct {
+	Revision string
+}
+
+func (e NoReadyPodError) Error() string {
+	return fmt.Sprintf("no ready pod found for revision '%s'", e.Revision)
+}
+
+// ReadyPod returns a ready pod of the revision. The oldest pod is preferred as it's the
+// least likely to be removed when scaling down. A NoReadyPodError is returned if there
+// is no ready pod.
+func ReadyPod(ctx context.Context, client kubernetes.Interface, namespace, revision string) (*corev1.Pod, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: revisionSelector(revision)})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.Before(&pods.Items[j].CreationTimestamp)
+	})
+	for i := range pods.Items {
+		if isPodReady(&pods.Items[i]) {
+			return &pods.Items[i], nil
+		}
+	}
+	return nil, NoReadyPodError{Revision: revision}
+}
+
+// WaitForReadyPod waits until the revision has a ready pod and returns it
+func WaitForReadyPod(ctx context.Context, client kubernetes.Interface, namespace, revision string, timeout time.Duration) (*corev1.Pod, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	watcher, err := client.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{LabelSelector: revisionSelector(revision)})
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	// a pod might have become ready before the watch started
+	pod, err := ReadyPod(ctx, client, namespace, revision)
+	var noPod NoReadyPodError
+	if !errors.As(err, &noPod) {
+		return pod, err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout while waiting for a ready pod of revision '%s': %w", revision, ctx.Err())
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("watch for pods of revision '%s' closed unexpectedly", revision)
+			}
+			if pod, isPod := event.Object.(*corev1.Pod); isPod && event.Type != watch.Deleted && isPodReady(pod) {
+				return pod, nil
+			}
+		}
+	}
+}
+
+// ScaleUpRevision makes sure that the revision has at least one pod by setting its min-scale
+// annotation to 1. The returned function restores the original annotation.
+func ScaleUpRevision(ctx context.Context, client KnServingClient, name string) (func(context.Context) error, error) {
+	revision, err := client.GetRevision(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	original, present := revision.Annotations[autoscaling.MinScaleAnnotationKey]
+	if revision.Annotations == nil {
+		revision.Annotations = map[string]string{}
+	}
+	revision.Annotations[autoscaling.MinScaleAnnotationKey] = "1"
+	if err := client.UpdateRevision(ctx, revision); err != nil {
+		return nil, fmt.Errorf("cannot scale up revision '%s': %w", name, err)
+	}
+	return func(ctx context.Context) error {
+		revision, err := client.GetRevision(ctx, name)
+		if err != nil {
+			return err
+		}
+		if present {
+			revision.Annotations[autoscaling.MinScaleAnnotationKey] = original
+		} else {
+			delete(revision.Annotations, autoscaling.MinScaleAnnotationKey)
+		}
+		if err := client.UpdateRevision(ctx, revision); err != nil {
+			return fmt.Errorf("cannot restore annotation %s of revision '%s': %w", autoscaling.MinScaleAnnotationKey, name, err)
+		}
+		return nil
+	}, nil
+}
+
+// UserContainer returns the name of the first container of the pod which
+// hasn't been injected by Knative serving
+func UserContainer(pod *corev1.Pod) string {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != QueueProxyContainerName {
+			return container.Name
+		}
+	}
+	return ""
+}
+
+func revisionSelector(revision string) string {
+	return labels.Set{serving.RevisionLabelKey: revision}.String()
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// ExecOptions are the options for running a command in a container
+type ExecOptions struct {
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	TTY       bool
+}
+
+// PodConnector connects to pods directly, bypassing the routing of Knative serving
+type PodConnector interface {
+	// PortForward forwards the local ports to the pod until the context is cancelled.
+	// Ports are given as "LOCAL:REMOTE". Messages about the forwarded ports are written to out.
+	PortForward(ctx context.Context, pod *corev1.Pod, ports []string, out, errOut io.Writer) error
+
+	// Exec runs a command in a container of the pod
+	Exec(ctx context.Context, pod *corev1.Pod, opts ExecOptions) error
+}
+
+type podConnector struct {
+	config *rest.Config
+	client kubernetes.Interface
+}
+
+// NewPodConnector creates a connector which uses the given configuration
+func NewPodConnector(config *rest.Config) (PodConnector, error) {
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &podConnector{config: config, client: client}, nil
+}
+
+func (c *podConnector) PortForward(ctx context.Context, pod *corev1.Pod, ports []string, out, errOut io.Writer) error {
+	transport, upgrader, err := spdy.RoundTripperFor(c.config)
+	if err != nil {
+		return err
+	}
+	url := c.client.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(pod.Namespace).Name(pod.Name).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stop, done := make(chan struct{}), make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		close(stop)
+	}()
+	forwarder, err := portforward.New(dialer, ports, stop, nil, out, errOut)
+	if err != nil {
+		return err
+	}
+	return forwarder.ForwardPorts()
+}
+
+func (c *podConnector) Exec(ctx context.Context, pod *corev1.Pod, opts ExecOptions) error {
+	url := c.client.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(pod.Namespace).Name(pod.Name).SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    opts.Stdout != nil,
+			Stderr:    opts.Stderr != nil && !opts.TTY,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec).URL()
+	executor, err := remotecommand.NewSPDYExecutor(c.config, http.MethodPost, url)
+	if err != nil {
+		return err
+	}
+	streamOptions := remotecommand.StreamOptions{Stdin: opts.Stdin, Stdout: opts.Stdout, Tty: opts.TTY}
+	if !opts.TTY {
+		streamOptions.Stderr = opts.Stderr
+	}
+	return executor.StreamWithContext(ctx, streamOptions)
+}
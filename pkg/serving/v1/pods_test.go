@@ -0,0 +1,137 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestReadyPod(t *testing.T) {
+	now := time.Now()
+	client := fake.NewSimpleClientset(
+		newRevisionPod("foo-00001-new", "foo-00001", true, now),
+		newRevisionPod("foo-00001-old", "foo-00001", true, now.Add(-time.Hour)),
+		newRevisionPod("foo-00001-older", "foo-00001", false, now.Add(-2*time.Hour)),
+		newRevisionPod("foo-00002-1", "foo-00002", false, now),
+	)
+
+	pod, err := ReadyPod(context.Background(), client, "default", "foo-00001")
+	assert.NilError(t, err)
+	assert.Equal(t, pod.Name, "foo-00001-old")
+
+	_, err = ReadyPod(context.Background(), client, "default", "foo-00002")
+	var noPod NoReadyPodError
+	assert.Assert(t, errors.As(err, &noPod))
+	assert.Equal(t, noPod.Revision, "foo-00002")
+	assert.ErrorContains(t, err, "no ready pod found for revision 'foo-00002'")
+}
+
+func TestWaitForReadyPod(t *testing.T) {
+	client := fake.NewSimpleClientset(newRevisionPod("foo-00001-1", "foo-00001", false, time.Now()))
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		pod := newRevisionPod("foo-00001-1", "foo-00001", true, time.Now())
+		_, err := client.CoreV1().Pods("default").UpdateStatus(context.Background(), pod, metav1.UpdateOptions{})
+		assert.Check(t, err)
+	}()
+	pod, err := WaitForReadyPod(context.Background(), client, "default", "foo-00001", 5*time.Second)
+	assert.NilError(t, err)
+	assert.Equal(t, pod.Name, "foo-00001-1")
+
+	_, err = WaitForReadyPod(context.Background(), client, "default", "foo-00002", 10*time.Millisecond)
+	assert.ErrorContains(t, err, "timeout while waiting for a ready pod of revision 'foo-00002'")
+}
+
+func TestScaleUpRevision(t *testing.T) {
+	client := NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetRevision("foo-00001", newScaleRevision("foo-00001", ""), nil)
+	r.UpdateRevision(assertMinScale("1"), nil)
+	r.GetRevision("foo-00001", newScaleRevision("foo-00001", "1"), nil)
+	r.UpdateRevision(assertMinScale(""), nil)
+
+	r.GetRevision("foo-00002", newScaleRevision("foo-00002", "0"), nil)
+	r.UpdateRevision(assertMinScale("1"), nil)
+	r.GetRevision("foo-00002", newScaleRevision("foo-00002", "1"), nil)
+	r.UpdateRevision(assertMinScale("0"), nil)
+
+	r.GetRevision("foo-00003", newScaleRevision("foo-00003", ""), nil)
+	r.UpdateRevision(assertMinScale("1"), errors.New("forbidden"))
+
+	restore, err := ScaleUpRevision(context.Background(), client, "foo-00001")
+	assert.NilError(t, err)
+	assert.NilError(t, restore(context.Background()))
+
+	restore, err = ScaleUpRevision(context.Background(), client, "foo-00002")
+	assert.NilError(t, err)
+	assert.NilError(t, restore(context.Background()))
+
+	_, err = ScaleUpRevision(context.Background(), client, "foo-00003")
+	assert.ErrorContains(t, err, "cannot scale up revision 'foo-00003': forbidden")
+
+	r.Validate()
+}
+
+func TestUserContainer(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: QueueProxyContainerName}, {Name: "app"}}}}
+	assert.Equal(t, UserContainer(pod), "app")
+	assert.Equal(t, UserContainer(&corev1.Pod{}), "")
+}
+
+func assertMinScale(expected string) func(t *testing.T, revision *servingv1.Revision) {
+	return func(t *testing.T, revision *servingv1.Revision) {
+		minScale, ok := revision.Annotations[autoscaling.MinScaleAnnotationKey]
+		assert.Equal(t, ok, expected != "")
+		assert.Equal(t, minScale, expected)
+	}
+}
+
+func newScaleRevision(name, minScale string) *servingv1.Revision {
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	if minScale != "" {
+		revision.Annotations = map[string]string{autoscaling.MinScaleAnnotationKey: minScale}
+	}
+	return revision
+}
+
+func newRevisionPod(name, revision string, ready bool, created time.Time) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{serving.RevisionLabelKey: revision},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	if ready {
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	}
+	return pod
+}
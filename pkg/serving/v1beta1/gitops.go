@@ -0,0 +1,79 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+
+	"knative.dev/client/pkg/gitops"
+)
+
+const domainMappingKind = "domainmapping"
+
+// knServingGitOpsClient works on domain mappings stored
+// in a local directory instead of a remote cluster
+type knServingGitOpsClient struct {
+	domainMappings *gitops.Store[servingv1beta1.DomainMapping, *servingv1beta1.DomainMapping]
+}
+
+// NewKnServingGitOpsClient returns a client which works on the local path given
+// as dir, which is either a directory or a single file
+func NewKnServingGitOpsClient(namespace, dir string) KnServingClient {
+	return &knServingGitOpsClient{
+		domainMappings: gitops.NewStore[servingv1beta1.DomainMapping](namespace, dir, domainMappingKind, servingv1beta1.Resource("domainmappings"), updateServingGvk),
+	}
+}
+
+// Namespace returns the namespace
+func (cl *knServingGitOpsClient) Namespace() string {
+	return cl.domainMappings.Namespace()
+}
+
+// GetDomainMapping reads the domain mapping from the local path
+func (cl *knServingGitOpsClient) GetDomainMapping(ctx context.Context, name string) (*servingv1beta1.DomainMapping, error) {
+	return cl.domainMappings.Get(name)
+}
+
+// CreateDomainMapping saves the domain mapping in the local path
+func (cl *knServingGitOpsClient) CreateDomainMapping(ctx context.Context, domainMapping *servingv1beta1.DomainMapping) error {
+	return cl.domainMappings.Create(domainMapping)
+}
+
+// UpdateDomainMapping overwrites the domain mapping in the local path
+func (cl *knServingGitOpsClient) UpdateDomainMapping(ctx context.Context, domainMapping *servingv1beta1.DomainMapping) error {
+	return cl.domainMappings.Update(domainMapping)
+}
+
+// UpdateDomainMappingWithRetry updates the domain mapping in the local path
+func (cl *knServingGitOpsClient) UpdateDomainMappingWithRetry(ctx context.Context, name string, updateFunc DomainUpdateFunc, nrRetries int) error {
+	return updateDomainMappingWithRetry(ctx, cl, name, updateFunc, nrRetries)
+}
+
+// DeleteDomainMapping removes the domain mapping from the local path
+func (cl *knServingGitOpsClient) DeleteDomainMapping(ctx context.Context, name string) error {
+	return cl.domainMappings.Delete(name)
+}
+
+// ListDomainMappings lists the domain mappings in the local path
+func (cl *knServingGitOpsClient) ListDomainMappings(ctx context.Context) (*servingv1beta1.DomainMappingList, error) {
+	items, err := cl.domainMappings.List()
+	if err != nil {
+		return nil, err
+	}
+	domainMappingList := &servingv1beta1.DomainMappingList{Items: items}
+	return domainMappingList, updateServingGvk(domainMappingList)
+}
@@ -0,0 +1,79 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+)
+
+func TestGitOpsDomainMappings(t *testing.T) {
+	dir := t.TempDir()
+	client := NewKnServingGitOpsClient("default", dir)
+	ctx := context.Background()
+
+	assert.Equal(t, client.Namespace(), "default")
+
+	err := client.CreateDomainMapping(ctx, createDomainMapping("foo.bar", createServiceRef("foo", "default")))
+	assert.NilError(t, err)
+	assert.Assert(t, fileExists(filepath.Join(dir, "default", "domainmapping", "foo.bar.yaml")))
+
+	err = client.UpdateDomainMappingWithRetry(ctx, "foo.bar", func(dm *servingv1beta1.DomainMapping) (*servingv1beta1.DomainMapping, error) {
+		dm.Spec.Ref = createServiceRef("bar", "default")
+		return dm, nil
+	}, 1)
+	assert.NilError(t, err)
+
+	dm, err := client.GetDomainMapping(ctx, "foo.bar")
+	assert.NilError(t, err)
+	assert.Equal(t, dm.Spec.Ref.Name, "bar")
+	validateGroupVersionKind(t, dm)
+
+	list, err := client.ListDomainMappings(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 1)
+
+	assert.NilError(t, client.DeleteDomainMapping(ctx, "foo.bar"))
+	_, err = client.GetDomainMapping(ctx, "foo.bar")
+	assert.ErrorType(t, err, apierrors.IsNotFound)
+}
+
+func TestGitOpsDomainMappingsSingleFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dm.json")
+	client := NewKnServingGitOpsClient("", file)
+	ctx := context.Background()
+
+	err := client.CreateDomainMapping(ctx, createDomainMapping("foo.bar", createServiceRef("foo", "default")))
+	assert.NilError(t, err)
+	assert.Assert(t, fileExists(file))
+
+	dm, err := client.GetDomainMapping(ctx, "foo.bar")
+	assert.NilError(t, err)
+	assert.Equal(t, dm.Spec.Ref.Name, "foo")
+
+	err = client.CreateDomainMapping(ctx, createDomainMapping("foo.bar", createServiceRef("foo", "default")))
+	assert.ErrorType(t, err, apierrors.IsAlreadyExists)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,214 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+
+	"knative.dev/client/pkg/gitops"
+)
+
+const (
+	pingSourceKind      = "pingsource"
+	sinkBindingKind     = "sinkbinding"
+	apiServerSourceKind = "apiserversource"
+	containerSourceKind = "containersource"
+)
+
+// sourcesGitOpsClient works on sources stored in
+// a local directory instead of a remote cluster
+type sourcesGitOpsClient struct {
+	namespace string
+	dir       string
+}
+
+// NewKnSourcesGitOpsClient returns a sources client which works on the local path
+// given as dir, which is either a directory or a single file
+func NewKnSourcesGitOpsClient(namespace, dir string) KnSourcesClient {
+	return &sourcesGitOpsClient{
+		namespace: namespace,
+		dir:       dir,
+	}
+}
+
+// PingSourcesClient for Ping sources in the local path
+func (c *sourcesGitOpsClient) PingSourcesClient() KnPingSourcesClient {
+	return &pingSourcesGitOpsClient{
+		store: gitops.NewStore[sourcesv1.PingSource](c.namespace, c.dir, pingSourceKind, sourcesv1.Resource("pingsources"), updatePingSourceGVK),
+	}
+}
+
+// SinkBindingClient for sink bindings in the local path
+func (c *sourcesGitOpsClient) SinkBindingClient() KnSinkBindingClient {
+	return &sinkBindingGitOpsClient{
+		store: gitops.NewStore[sourcesv1.SinkBinding](c.namespace, c.dir, sinkBindingKind, sourcesv1.Resource("sinkbindings"), updateSinkBindingGvk),
+	}
+}
+
+// APIServerSourcesClient for ApiServer sources in the local path
+func (c *sourcesGitOpsClient) APIServerSourcesClient() KnAPIServerSourcesClient {
+	return &apiServerSourcesGitOpsClient{
+		store: gitops.NewStore[sourcesv1.ApiServerSource](c.namespace, c.dir, apiServerSourceKind, sourcesv1.Resource("apiserversources"), updateSourceGVK),
+	}
+}
+
+// ContainerSourcesClient for container sources in the local path
+func (c *sourcesGitOpsClient) ContainerSourcesClient() KnContainerSourcesClient {
+	return &containerSourcesGitOpsClient{
+		store: gitops.NewStore[sourcesv1.ContainerSource](c.namespace, c.dir, containerSourceKind, sourcesv1.Resource("containersources"), updateContainerSourceGvk),
+	}
+}
+
+type pingSourcesGitOpsClient struct {
+	store *gitops.Store[sourcesv1.PingSource, *sourcesv1.PingSource]
+}
+
+func (c *pingSourcesGitOpsClient) GetPingSource(ctx context.Context, name string) (*sourcesv1.PingSource, error) {
+	return c.store.Get(name)
+}
+
+func (c *pingSourcesGitOpsClient) CreatePingSource(ctx context.Context, pingSource *sourcesv1.PingSource) error {
+	return c.store.Create(pingSource)
+}
+
+func (c *pingSourcesGitOpsClient) UpdatePingSource(ctx context.Context, pingSource *sourcesv1.PingSource) error {
+	return c.store.Update(pingSource)
+}
+
+func (c *pingSourcesGitOpsClient) UpdatePingSourceWithRetry(ctx context.Context, name string, updateFunc PingSourceUpdateFunc, nrRetries int) error {
+	return updatePingSourceWithRetry(ctx, c, name, updateFunc, nrRetries)
+}
+
+func (c *pingSourcesGitOpsClient) DeletePingSource(ctx context.Context, name string) error {
+	return c.store.Delete(name)
+}
+
+func (c *pingSourcesGitOpsClient) ListPingSource(ctx context.Context) (*sourcesv1.PingSourceList, error) {
+	items, err := c.store.List()
+	if err != nil {
+		return nil, err
+	}
+	sourceList := &sourcesv1.PingSourceList{Items: items}
+	return sourceList, updatePingSourceGVK(sourceList)
+}
+
+func (c *pingSourcesGitOpsClient) Namespace() string {
+	return c.store.Namespace()
+}
+
+type sinkBindingGitOpsClient struct {
+	store *gitops.Store[sourcesv1.SinkBinding, *sourcesv1.SinkBinding]
+}
+
+func (c *sinkBindingGitOpsClient) Namespace() string {
+	return c.store.Namespace()
+}
+
+func (c *sinkBindingGitOpsClient) CreateSinkBinding(ctx context.Context, binding *sourcesv1.SinkBinding) error {
+	return c.store.Create(binding)
+}
+
+func (c *sinkBindingGitOpsClient) DeleteSinkBinding(ctx context.Context, name string) error {
+	return c.store.Delete(name)
+}
+
+func (c *sinkBindingGitOpsClient) GetSinkBinding(ctx context.Context, name string) (*sourcesv1.SinkBinding, error) {
+	return c.store.Get(name)
+}
+
+func (c *sinkBindingGitOpsClient) ListSinkBindings(ctx context.Context) (*sourcesv1.SinkBindingList, error) {
+	items, err := c.store.List()
+	if err != nil {
+		return nil, err
+	}
+	bindingList := &sourcesv1.SinkBindingList{Items: items}
+	return bindingList, updateSinkBindingGvk(bindingList)
+}
+
+func (c *sinkBindingGitOpsClient) UpdateSinkBinding(ctx context.Context, binding *sourcesv1.SinkBinding) error {
+	return c.store.Update(binding)
+}
+
+type apiServerSourcesGitOpsClient struct {
+	store *gitops.Store[sourcesv1.ApiServerSource, *sourcesv1.ApiServerSource]
+}
+
+func (c *apiServerSourcesGitOpsClient) GetAPIServerSource(ctx context.Context, name string) (*sourcesv1.ApiServerSource, error) {
+	return c.store.Get(name)
+}
+
+func (c *apiServerSourcesGitOpsClient) CreateAPIServerSource(ctx context.Context, apiSource *sourcesv1.ApiServerSource) error {
+	return c.store.Create(apiSource)
+}
+
+func (c *apiServerSourcesGitOpsClient) UpdateAPIServerSource(ctx context.Context, apiSource *sourcesv1.ApiServerSource) error {
+	return c.store.Update(apiSource)
+}
+
+func (c *apiServerSourcesGitOpsClient) DeleteAPIServerSource(ctx context.Context, name string) error {
+	return c.store.Delete(name)
+}
+
+func (c *apiServerSourcesGitOpsClient) ListAPIServerSource(ctx context.Context) (*sourcesv1.ApiServerSourceList, error) {
+	items, err := c.store.List()
+	if err != nil {
+		return nil, err
+	}
+	sourceList := &sourcesv1.ApiServerSourceList{Items: items}
+	return sourceList, updateSourceGVK(sourceList)
+}
+
+func (c *apiServerSourcesGitOpsClient) Namespace() string {
+	return c.store.Namespace()
+}
+
+type containerSourcesGitOpsClient struct {
+	store *gitops.Store[sourcesv1.ContainerSource, *sourcesv1.ContainerSource]
+}
+
+func (c *containerSourcesGitOpsClient) GetContainerSource(ctx context.Context, name string) (*sourcesv1.ContainerSource, error) {
+	return c.store.Get(name)
+}
+
+func (c *containerSourcesGitOpsClient) CreateContainerSource(ctx context.Context, containerSrc *sourcesv1.ContainerSource) error {
+	return c.store.Create(containerSrc)
+}
+
+func (c *containerSourcesGitOpsClient) UpdateContainerSource(ctx context.Context, containerSrc *sourcesv1.ContainerSource) error {
+	return c.store.Update(containerSrc)
+}
+
+func (c *containerSourcesGitOpsClient) UpdateContainerSourceWithRetry(ctx context.Context, name string, updateFunc ContainerUpdateFunc, nrRetries int) error {
+	return updateContainerSourceWithRetry(ctx, c, name, updateFunc, nrRetries)
+}
+
+func (c *containerSourcesGitOpsClient) DeleteContainerSource(name string, ctx context.Context) error {
+	return c.store.Delete(name)
+}
+
+func (c *containerSourcesGitOpsClient) ListContainerSources(ctx context.Context) (*sourcesv1.ContainerSourceList, error) {
+	items, err := c.store.List()
+	if err != nil {
+		return nil, err
+	}
+	sourceList := &sourcesv1.ContainerSourceList{Items: items}
+	return sourceList, updateContainerSourceGvk(sourceList)
+}
+
+func (c *containerSourcesGitOpsClient) Namespace() string {
+	return c.store.Namespace()
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func TestSourcesGitOpsClient(t *testing.T) {
+	dir := t.TempDir()
+	client := NewKnSourcesGitOpsClient("foo-ns", dir)
+	ctx := context.Background()
+
+	t.Run("ping sources", func(t *testing.T) {
+		pingClient := client.PingSourcesClient()
+		assert.NilError(t, pingClient.CreatePingSource(ctx, newPingSource("p1", "mysvc")))
+		assert.NilError(t, pingClient.UpdatePingSourceWithRetry(ctx, "p1", func(source *sourcesv1.PingSource) (*sourcesv1.PingSource, error) {
+			source.Spec.Schedule = "0 * * * *"
+			return source, nil
+		}, 1))
+		source, err := pingClient.GetPingSource(ctx, "p1")
+		assert.NilError(t, err)
+		assert.Equal(t, source.Spec.Schedule, "0 * * * *")
+		assert.Equal(t, source.Kind, "PingSource")
+
+		sources, err := pingClient.ListPingSource(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(sources.Items), 1)
+
+		assert.NilError(t, pingClient.DeletePingSource(ctx, "p1"))
+		_, err = pingClient.GetPingSource(ctx, "p1")
+		assert.ErrorType(t, err, apierrors.IsNotFound)
+	})
+	t.Run("sink bindings", func(t *testing.T) {
+		bindingClient := client.SinkBindingClient()
+		assert.NilError(t, bindingClient.CreateSinkBinding(ctx, newSinkBinding("b1", "mysvc", "mping")))
+		bindings, err := bindingClient.ListSinkBindings(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(bindings.Items), 1)
+		assert.NilError(t, bindingClient.DeleteSinkBinding(ctx, "b1"))
+	})
+	t.Run("api server sources", func(t *testing.T) {
+		apiServerClient := client.APIServerSourcesClient()
+		assert.NilError(t, apiServerClient.CreateAPIServerSource(ctx, newAPIServerSource("a1", "Event")))
+		source, err := apiServerClient.GetAPIServerSource(ctx, "a1")
+		assert.NilError(t, err)
+		assert.Equal(t, source.Kind, "ApiServerSource")
+		assert.NilError(t, apiServerClient.DeleteAPIServerSource(ctx, "a1"))
+	})
+	t.Run("container sources", func(t *testing.T) {
+		containerClient := client.ContainerSourcesClient()
+		assert.NilError(t, containerClient.CreateContainerSource(ctx, newContainerSource("c1", "mycontainer")))
+		sources, err := containerClient.ListContainerSources(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(sources.Items), 1)
+		assert.NilError(t, containerClient.DeleteContainerSource("c1", ctx))
+	})
+}
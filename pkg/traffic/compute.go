@@ -400,6 +400,20 @@ func checkRevisionPresent(refMap map[string]int, rev servingv1.Revision) bool {
 // traffic. Param 'mutation' is set to true if a new revision will be created on service update
 func Compute(cmd *cobra.Command, svc *servingv1.Service,
 	trafficFlags *flags.Traffic, allRevisions []servingv1.Revision, mutation bool) ([]servingv1.TrafficTarget, error) {
+	if trafficFlags.File != "" {
+		if trafficFlags.PercentagesChanged(cmd) || trafficFlags.TagsChanged(cmd) {
+			return nil, fmt.Errorf("--traffic-file can't be used together with --traffic, --tag or --untag")
+		}
+		return computeFromFile(svc, trafficFlags.File, allRevisions, mutation)
+	}
+	setPercentages := cmd.Flags().Changed("traffic") || (cmd.Name() == "create" && len(trafficFlags.RevisionsTags) > 0)
+	return compute(svc, trafficFlags, allRevisions, mutation, setPercentages)
+}
+
+// compute computes the traffic of the service per given traffic flags. The percentages of
+// the flags replace the existing ones if setPercentages is true.
+func compute(svc *servingv1.Service, trafficFlags *flags.Traffic, allRevisions []servingv1.Revision,
+	mutation bool, setPercentages bool) ([]servingv1.TrafficTarget, error) {
 	targets := svc.Spec.Traffic
 	serviceName := svc.Name
 	revisions := svc.Status.Traffic
@@ -465,7 +479,7 @@ func Compute(cmd *cobra.Command, svc *servingv1.Service,
 		traffic = traffic.TagRevision(tag, revision)
 	}
 
-	if cmd.Flags().Changed("traffic") || (cmd.Name() == "create" && len(trafficFlags.RevisionsTags) > 0) {
+	if setPercentages {
 		// reset existing traffic portions as what's on CLI is desired state of traffic split portions
 		traffic.ResetAllTargetPercent()
 
@@ -0,0 +1,126 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"fmt"
+	"os"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/commands/flags"
+)
+
+// fileTarget is a traffic target of a traffic file. It references either a revision, the
+// latest revision or the revision having the given tag. The tag is assigned to the target
+// if it references a revision or the latest revision.
+type fileTarget struct {
+	Revision string `json:"revision,omitempty"`
+	Latest   bool   `json:"latest,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Percent  int64  `json:"percent,omitempty"`
+}
+
+// computeFromFile computes the traffic of the service from the targets of the traffic file.
+// The targets are translated to the corresponding traffic flags, so that they are verified
+// like the flags. As the file declares the complete traffic, existing tags are removed
+// unless they are given in the file, and the percentages have to sum to 100.
+func computeFromFile(svc *servingv1.Service, path string, allRevisions []servingv1.Revision, mutation bool) ([]servingv1.TrafficTarget, error) {
+	targets, err := readTrafficFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fileFlags := &flags.Traffic{}
+	for _, target := range svc.Spec.Traffic {
+		if target.Tag != "" {
+			fileFlags.UntagRevisions = append(fileFlags.UntagRevisions, target.Tag)
+		}
+	}
+	refs := map[string]bool{}
+	tags := map[string]bool{}
+	for i, target := range targets {
+		ref, err := target.revisionRef(svc, allRevisions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target %d in traffic file '%s': %w", i+1, path, err)
+		}
+		if refs[ref] {
+			return nil, errorRepeatingRevision("--traffic-file", ref)
+		}
+		refs[ref] = true
+		if target.Tag != "" {
+			if tags[target.Tag] {
+				return nil, fmt.Errorf("repetition of tag %s is not allowed, use only once with --traffic-file flag", target.Tag)
+			}
+			tags[target.Tag] = true
+			fileFlags.RevisionsTags = append(fileFlags.RevisionsTags, ref+"="+target.Tag)
+		}
+		fileFlags.RevisionsPercentages = append(fileFlags.RevisionsPercentages, fmt.Sprintf("%s=%d", ref, target.Percent))
+	}
+	// the file declares the complete traffic, so the remaining traffic isn't allocated automatically
+	_, sum, err := verifyRevisionSumAndReferences(fileFlags)
+	if err != nil {
+		return nil, err
+	}
+	if sum != 100 {
+		return nil, fmt.Errorf("given traffic percents sum to %d, want 100", sum)
+	}
+	return compute(svc, fileFlags, allRevisions, mutation, true)
+}
+
+func readTrafficFile(path string) ([]fileTarget, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read traffic file: %w", err)
+	}
+	var targets []fileTarget
+	if err := yaml.UnmarshalStrict(data, &targets); err != nil {
+		return nil, fmt.Errorf("cannot parse traffic file '%s': %w", path, err)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("traffic file '%s' doesn't contain any traffic targets", path)
+	}
+	return targets, nil
+}
+
+// revisionRef returns the revision reference of the target as used by the traffic flags
+func (t fileTarget) revisionRef(svc *servingv1.Service, allRevisions []servingv1.Revision) (string, error) {
+	switch {
+	case t.Revision != "" && t.Latest:
+		return "", fmt.Errorf("either 'revision' or 'latest' can be given, but not both")
+	case t.Latest:
+		return latestRevisionRef, nil
+	case t.Revision != "":
+		if allRevisions != nil && getRevisionFromList(allRevisions, t.Revision) == nil {
+			return "", fmt.Errorf("revision %s not found for service %s", t.Revision, svc.Name)
+		}
+		return t.Revision, nil
+	case t.Tag != "":
+		// the target references the revision having the tag, which is kept
+		for _, target := range svc.Spec.Traffic {
+			if target.Tag != t.Tag {
+				continue
+			}
+			if target.LatestRevision != nil && *target.LatestRevision {
+				return latestRevisionRef, nil
+			}
+			return target.RevisionName, nil
+		}
+		return "", fmt.Errorf("tag(s) %s not present for any revisions of service %s", t.Tag, svc.Name)
+	default:
+		return "", fmt.Errorf("one of 'revision', 'tag' or 'latest' is required")
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestComputeFromFile(t *testing.T) {
+	existingTraffic := func() ServiceTraffic {
+		return ServiceTraffic{
+			newTarget("", "", 80, true),
+			newTarget("stable", "rev-00001", 20, false),
+			newTarget("old", "rev-00002", 0, false),
+		}
+	}
+	revisions := fileTestRevisions("rev-00001", "rev-00002", "rev-00003")
+	for _, testCase := range []struct {
+		name     string
+		file     string
+		expected ServiceTraffic
+	}{
+		{
+			name: "split between revisions with new tags",
+			file: `
+- revision: rev-00001
+  percent: 50
+- revision: rev-00003
+  percent: 50
+  tag: candidate`,
+			expected: ServiceTraffic{
+				newTarget("", "rev-00001", 50, false),
+				newTarget("candidate", "rev-00003", 50, false),
+			},
+		},
+		{
+			name: "reference existing tag and latest revision",
+			file: `
+- tag: stable
+  percent: 90
+- latest: true
+  tag: current
+  percent: 10`,
+			expected: ServiceTraffic{
+				newTarget("current", "", 10, true),
+				newTarget("stable", "rev-00001", 90, false),
+			},
+		},
+		{
+			name: "JSON",
+			file: `[{"latest": true, "percent": 100}]`,
+			expected: ServiceTraffic{
+				newTarget("", "", 100, true),
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCmd, tFlags := newTestTrafficCommand()
+			testCmd.SetArgs([]string{"--traffic-file", writeTrafficFile(t, testCase.file)})
+			testCmd.Execute()
+			svc := getService("serviceName", "rev-00003", existingTraffic())
+			targets, err := Compute(testCmd, svc, tFlags, revisions, true)
+			assert.NilError(t, err)
+			for _, expected := range testCase.expected {
+				found := false
+				for _, target := range targets {
+					if TargetName(target) == TargetName(expected) {
+						found = true
+						assert.Equal(t, *target.Percent, *expected.Percent, TargetName(target))
+					}
+				}
+				assert.Assert(t, found, "target %s not found in %v", TargetName(expected), targets)
+			}
+			assert.Equal(t, len(targets), len(testCase.expected))
+		})
+	}
+}
+
+func TestComputeFromFileErrMsg(t *testing.T) {
+	existingTraffic := func() ServiceTraffic {
+		return ServiceTraffic{
+			newTarget("", "", 80, true),
+			newTarget("stable", "rev-00001", 20, false),
+		}
+	}
+	revisions := fileTestRevisions("rev-00001", "rev-00002")
+	for _, testCase := range []struct {
+		name   string
+		file   string
+		flags  []string
+		errMsg string
+	}{
+		{
+			name:   "sum not 100",
+			file:   "[{revision: rev-00001, percent: 50}, {latest: true, percent: 20}]",
+			errMsg: "given traffic percents sum to 70, want 100",
+		},
+		{
+			name:   "percent out of range",
+			file:   "[{revision: rev-00001, percent: 150}]",
+			errMsg: "invalid value for traffic percent 150, expected 0 <= percent <= 100",
+		},
+		{
+			name:   "repeated revision",
+			file:   "[{revision: rev-00001, percent: 50}, {tag: stable, percent: 50}]",
+			errMsg: "repetition of revision reference rev-00001 is not allowed, use only once with --traffic-file flag",
+		},
+		{
+			name:   "repeated tag",
+			file:   "[{revision: rev-00002, percent: 50, tag: x}, {latest: true, percent: 50, tag: x}]",
+			errMsg: "repetition of tag x is not allowed, use only once with --traffic-file flag",
+		},
+		{
+			name:   "unknown revision",
+			file:   "[{revision: rev-00009, percent: 100}]",
+			errMsg: "revision rev-00009 not found for service serviceName",
+		},
+		{
+			name:   "unknown tag",
+			file:   "[{tag: canary, percent: 100}]",
+			errMsg: "tag(s) canary not present for any revisions of service serviceName",
+		},
+		{
+			name:   "missing reference",
+			file:   "[{percent: 100}]",
+			errMsg: "one of 'revision', 'tag' or 'latest' is required",
+		},
+		{
+			name:   "unknown field",
+			file:   "[{revision: rev-00001, weight: 100}]",
+			errMsg: "cannot parse traffic file",
+		},
+		{
+			name:   "empty",
+			file:   "[]",
+			errMsg: "doesn't contain any traffic targets",
+		},
+		{
+			name:   "combined with flags",
+			file:   "[{latest: true, percent: 100}]",
+			flags:  []string{"--untag", "stable"},
+			errMsg: "--traffic-file can't be used together with --traffic, --tag or --untag",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCmd, tFlags := newTestTrafficCommand()
+			testCmd.SetArgs(append([]string{"--traffic-file", writeTrafficFile(t, testCase.file)}, testCase.flags...))
+			testCmd.Execute()
+			svc := getService("serviceName", "rev-00002", existingTraffic())
+			_, err := Compute(testCmd, svc, tFlags, revisions, true)
+			assert.ErrorContains(t, err, testCase.errMsg)
+		})
+	}
+
+	testCmd, tFlags := newTestTrafficCommand()
+	testCmd.SetArgs([]string{"--traffic-file", filepath.Join(t.TempDir(), "missing.yaml")})
+	testCmd.Execute()
+	_, err := Compute(testCmd, getService("serviceName", "rev-00002", existingTraffic()), tFlags, revisions, true)
+	assert.ErrorContains(t, err, "cannot read traffic file")
+}
+
+func writeTrafficFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "traffic.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func fileTestRevisions(names ...string) []servingv1.Revision {
+	var revisions []servingv1.Revision
+	for _, name := range names {
+		revisions = append(revisions, servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return revisions
+}
@@ -0,0 +1,148 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"fmt"
+	"strings"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/printers"
+)
+
+// graphWidth is the number of characters of the bar for 100% of the traffic
+const graphWidth = 20
+
+// RevisionStatus returns the status of a revision to show next to its traffic target,
+// or an empty string if unknown
+type RevisionStatus func(revisionName string) string
+
+// TargetMismatch is a traffic target which receives a different share of the traffic
+// than desired, e.g. while a rollout is in progress
+type TargetMismatch struct {
+	Target  string
+	Desired int64
+	Current int64
+}
+
+// WriteGraph writes the traffic targets as a bar chart, with the percentage, the revision
+// and tag, the status of the revision if given and the URL of tagged targets. Block
+// characters are used for the bars on fancy terminals, ASCII characters otherwise.
+func (e ServiceTraffic) WriteGraph(dw printers.PrefixWriter, status RevisionStatus, fancy bool) {
+	for _, target := range e {
+		var percent int64
+		if target.Percent != nil {
+			percent = *target.Percent
+		}
+		cols := []string{bar(percent, fancy), fmt.Sprintf("%3d%%", percent), TargetName(target)}
+		if status != nil {
+			cols = append(cols, status(target.RevisionName))
+		}
+		if target.Tag != "" && target.URL != nil {
+			cols = append(cols, target.URL.String())
+		}
+		dw.WriteColsLn(cols...)
+	}
+}
+
+// Mismatches compares the traffic with the current traffic and returns the targets whose
+// percentages differ, in the order of their first appearance. Targets following the latest
+// revision without a revision name, like in the spec of a service, are resolved to the
+// given latest revision.
+func (e ServiceTraffic) Mismatches(current ServiceTraffic, latestRevision string) []TargetMismatch {
+	var mismatches []TargetMismatch
+	index := map[string]int{}
+	add := func(traffic ServiceTraffic, percent func(m *TargetMismatch) *int64) {
+		for _, target := range traffic {
+			if target.LatestRevision != nil && *target.LatestRevision && target.RevisionName == "" {
+				target.RevisionName = latestRevision
+			}
+			name := TargetName(target)
+			i, ok := index[name]
+			if !ok {
+				i = len(mismatches)
+				index[name] = i
+				mismatches = append(mismatches, TargetMismatch{Target: name})
+			}
+			if target.Percent != nil {
+				*percent(&mismatches[i]) += *target.Percent
+			}
+		}
+	}
+	add(e, func(m *TargetMismatch) *int64 { return &m.Desired })
+	add(current, func(m *TargetMismatch) *int64 { return &m.Current })
+
+	var result []TargetMismatch
+	for _, m := range mismatches {
+		if m.Desired != m.Current {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// RevisionReadiness returns a RevisionStatus showing whether the given revisions are ready,
+// or why they aren't
+func RevisionReadiness(revisions []servingv1.Revision) RevisionStatus {
+	byName := map[string]*servingv1.Revision{}
+	for i := range revisions {
+		byName[revisions[i].Name] = &revisions[i]
+	}
+	return func(revisionName string) string {
+		revision, ok := byName[revisionName]
+		if !ok {
+			return ""
+		}
+		switch commands.ReadyCondition(revision.Status.Conditions) {
+		case "True":
+			return "Ready"
+		case "False":
+			return "Not ready: " + commands.NonReadyConditionReason(revision.Status.Conditions)
+		default:
+			return "Unknown"
+		}
+	}
+}
+
+// TargetName returns the name of a traffic target, like "@latest (foo-00002) #current"
+func TargetName(target servingv1.TrafficTarget) string {
+	name := target.RevisionName
+	if target.LatestRevision != nil && *target.LatestRevision {
+		name = latestRevisionRef
+		if target.RevisionName != "" {
+			name = fmt.Sprintf("%s (%s)", latestRevisionRef, target.RevisionName)
+		}
+	}
+	if target.Tag != "" {
+		name = fmt.Sprintf("%s #%s", name, target.Tag)
+	}
+	return name
+}
+
+// bar renders the percentage as a bar, which is visible for any traffic above 0%
+func bar(percent int64, fancy bool) string {
+	full, empty := "#", "."
+	if fancy {
+		full, empty = "█", "░"
+	}
+	percent = max(0, min(percent, 100))
+	filled := int((percent*graphWidth + 50) / 100)
+	if percent > 0 && filled == 0 {
+		filled = 1
+	}
+	return strings.Repeat(full, filled) + strings.Repeat(empty, graphWidth-filled)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/printers"
+)
+
+func TestWriteGraph(t *testing.T) {
+	url, _ := apis.ParseURL("http://old-foo.example.com")
+	traffic := ServiceTraffic{
+		{RevisionName: "foo-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(99)},
+		{RevisionName: "foo-00001", Tag: "old", Percent: ptr.Int64(1), URL: url},
+		{RevisionName: "foo-00003", Tag: "next"},
+	}
+	status := RevisionReadiness([]servingv1.Revision{
+		newGraphRevision("foo-00001", corev1.ConditionTrue, ""),
+		newGraphRevision("foo-00002", corev1.ConditionFalse, "ContainerMissing"),
+		newGraphRevision("foo-00003", corev1.ConditionUnknown, ""),
+	})
+
+	buf := &bytes.Buffer{}
+	dw := printers.NewPrefixWriter(buf)
+	traffic.WriteGraph(dw, status, false)
+	assert.NilError(t, dw.Flush())
+	assert.Equal(t, buf.String(), ""+
+		"####################   99%  @latest (foo-00002)  Not ready: ContainerMissing\n"+
+		"#...................    1%  foo-00001 #old       Ready  http://old-foo.example.com\n"+
+		"....................    0%  foo-00003 #next      Unknown\n")
+
+	buf.Reset()
+	dw = printers.NewPrefixWriter(buf)
+	ServiceTraffic{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(50)}}.WriteGraph(dw, nil, true)
+	assert.NilError(t, dw.Flush())
+	assert.Equal(t, buf.String(), "██████████░░░░░░░░░░   50%  @latest\n")
+}
+
+func TestMismatches(t *testing.T) {
+	desired := ServiceTraffic{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		{RevisionName: "foo-00001", Tag: "old", Percent: ptr.Int64(0)},
+	}
+	// rollout to foo-00003 in progress
+	current := ServiceTraffic{
+		{RevisionName: "foo-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(80)},
+		{RevisionName: "foo-00003", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(20)},
+		{RevisionName: "foo-00001", Tag: "old", Percent: ptr.Int64(0)},
+	}
+	assert.DeepEqual(t, desired.Mismatches(current, "foo-00003"), []TargetMismatch{
+		{Target: "@latest (foo-00003)", Desired: 100, Current: 20},
+		{Target: "@latest (foo-00002)", Desired: 0, Current: 80},
+	})
+
+	current[0].Percent, current[1].Percent = ptr.Int64(0), ptr.Int64(100)
+	assert.DeepEqual(t, desired.Mismatches(current[1:], "foo-00003"), []TargetMismatch(nil))
+}
+
+func TestTargetName(t *testing.T) {
+	assert.Equal(t, TargetName(servingv1.TrafficTarget{RevisionName: "foo-00001"}), "foo-00001")
+	assert.Equal(t, TargetName(servingv1.TrafficTarget{LatestRevision: ptr.Bool(true), Tag: "current"}), "@latest #current")
+	assert.Equal(t, TargetName(servingv1.TrafficTarget{LatestRevision: ptr.Bool(true), RevisionName: "foo-00002"}), "@latest (foo-00002)")
+	assert.Equal(t, TargetName(servingv1.TrafficTarget{LatestRevision: ptr.Bool(false), RevisionName: "foo-00002", Tag: "v2"}), "foo-00002 #v2")
+}
+
+func newGraphRevision(name string, ready corev1.ConditionStatus, reason string) servingv1.Revision {
+	revision := servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	revision.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: ready, Reason: reason}}
+	return revision
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"fmt"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// Preview returns the traffic targets which assign the tag to the latest revision without
+// routing any traffic to it. All other targets are pinned to the latest ready revision, so
+// that the traffic split doesn't change when the new revision gets ready. If the tag is
+// assigned to another revision already, it's moved.
+func Preview(targets []servingv1.TrafficTarget, tag, latestReadyRevision string) (ServiceTraffic, error) {
+	pinned, err := PinLatestRevision(targets, latestReadyRevision)
+	if err != nil {
+		return nil, err
+	}
+	traffic := newServiceTraffic(pinned)
+	traffic.untagAll(tag)
+	traffic = traffic.TagLatestRevision(tag)
+	return traffic.RemoveNullTargets(), nil
+}
+
+// Promote returns the traffic targets which route all traffic to the revision behind the tag.
+// The revision which received the largest share of traffic before is tagged with previousTag,
+// which is removed from any other revision. The names of the promoted and the previous revision
+// are returned as well.
+func Promote(targets []servingv1.TrafficTarget, tag, previousTag, latestReadyRevision string) (traffic ServiceTraffic, promoted, previous string, err error) {
+	if previousTag == tag {
+		return nil, "", "", fmt.Errorf("tag '%s' can't be used for both the promoted and the previous revision", tag)
+	}
+	pinned, err := PinLatestRevision(targets, latestReadyRevision)
+	if err != nil {
+		return nil, "", "", err
+	}
+	traffic = newServiceTraffic(pinned)
+
+	for _, target := range traffic {
+		if target.Tag == tag {
+			promoted = target.RevisionName
+		}
+	}
+	if promoted == "" {
+		return nil, "", "", fmt.Errorf("tag '%s' not present for any revision", tag)
+	}
+
+	percents := map[string]int64{}
+	for _, target := range traffic {
+		if target.RevisionName == promoted || target.Percent == nil {
+			continue
+		}
+		percents[target.RevisionName] += *target.Percent
+		if percents[target.RevisionName] > percents[previous] {
+			previous = target.RevisionName
+		}
+	}
+	if previous == "" {
+		return nil, "", "", fmt.Errorf("revision '%s' with tag '%s' receives all traffic already", promoted, tag)
+	}
+	traffic.untagAll(previousTag)
+	traffic.ResetAllTargetPercent()
+	traffic.SetTrafficByTag(tag, 100)
+	traffic = traffic.TagRevision(previousTag, previous)
+	return traffic.RemoveNullTargets(), promoted, previous, nil
+}
+
+// untagAll removes the tag from all targets
+func (e ServiceTraffic) untagAll(tag string) {
+	for i := range e {
+		if e[i].Tag == tag {
+			e[i].Tag = ""
+		}
+	}
+}
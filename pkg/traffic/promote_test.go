@@ -0,0 +1,93 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestPreview(t *testing.T) {
+	targets := []servingv1.TrafficTarget{
+		newTarget("", "", 100, true),
+		newTarget("candidate", "echo-v0", 0, false),
+	}
+	traffic, err := Preview(targets, "candidate", "echo-v1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, traffic, ServiceTraffic{
+		newTarget("", "echo-v1", 100, false),
+		newTarget("candidate", "", 0, true),
+	})
+
+	_, err = Preview(targets, "candidate", "")
+	assert.ErrorContains(t, err, "no ready revision")
+}
+
+func TestPromote(t *testing.T) {
+	targets := []servingv1.TrafficTarget{
+		newTarget("", "echo-v1", 100, false),
+		newTarget("previous", "echo-v0", 0, false),
+		newTarget("candidate", "", 0, true),
+	}
+	traffic, promoted, previous, err := Promote(targets, "candidate", "previous", "echo-v2")
+	assert.NilError(t, err)
+	assert.Equal(t, promoted, "echo-v2")
+	assert.Equal(t, previous, "echo-v1")
+	assert.DeepEqual(t, traffic, ServiceTraffic{
+		newTarget("previous", "echo-v1", 0, false),
+		newTarget("candidate", "echo-v2", 100, false),
+	})
+
+	// promoting again fails, as the revision receives all traffic
+	_, _, _, err = Promote(traffic, "candidate", "previous", "echo-v2")
+	assert.ErrorContains(t, err, "receives all traffic already")
+
+	// promote back to the previous revision
+	traffic, promoted, previous, err = Promote(traffic, "previous", "candidate", "echo-v2")
+	assert.NilError(t, err)
+	assert.Equal(t, promoted, "echo-v1")
+	assert.Equal(t, previous, "echo-v2")
+	assert.DeepEqual(t, traffic, ServiceTraffic{
+		newTarget("previous", "echo-v1", 100, false),
+		newTarget("candidate", "echo-v2", 0, false),
+	})
+}
+
+func TestPromoteLargestShare(t *testing.T) {
+	targets := []servingv1.TrafficTarget{
+		newTarget("", "echo-v1", 30, false),
+		newTarget("", "echo-v2", 60, false),
+		newTarget("candidate", "echo-v3", 10, false),
+	}
+	traffic, _, previous, err := Promote(targets, "candidate", "previous", "echo-v3")
+	assert.NilError(t, err)
+	assert.Equal(t, previous, "echo-v2")
+	assert.DeepEqual(t, traffic, ServiceTraffic{
+		newTarget("previous", "echo-v2", 0, false),
+		newTarget("candidate", "echo-v3", 100, false),
+	})
+}
+
+func TestPromoteErrors(t *testing.T) {
+	targets := []servingv1.TrafficTarget{newTarget("", "echo-v1", 100, false)}
+
+	_, _, _, err := Promote(targets, "candidate", "previous", "echo-v1")
+	assert.ErrorContains(t, err, "tag 'candidate' not present")
+
+	_, _, _, err = Promote(targets, "candidate", "candidate", "echo-v1")
+	assert.ErrorContains(t, err, "can't be used for both")
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"fmt"
+
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// PinLatestRevision returns a copy of the traffic targets in which targets following the
+// latest ready revision are replaced by targets referring to that revision by name.
+// This makes sure that traffic isn't routed to a new revision as soon as it gets ready.
+// A service without any traffic targets routes all traffic to the latest ready revision.
+func PinLatestRevision(targets []servingv1.TrafficTarget, latestReadyRevision string) ([]servingv1.TrafficTarget, error) {
+	if len(targets) == 0 {
+		targets = []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	}
+	pinned := make([]servingv1.TrafficTarget, 0, len(targets))
+	for _, target := range targets {
+		target := *target.DeepCopy()
+		latest := target.LatestRevision != nil && *target.LatestRevision
+		if target.LatestRevision == nil && target.RevisionName == "" {
+			// a target without revision follows the latest revision
+			latest = true
+		}
+		if latest {
+			if latestReadyRevision == "" {
+				return nil, fmt.Errorf("cannot pin traffic as there is no ready revision")
+			}
+			target.RevisionName = latestReadyRevision
+		}
+		target.LatestRevision = ptr.Bool(false)
+		pinned = append(pinned, target)
+	}
+	return pinned, nil
+}
+
+// ShiftTraffic returns the traffic targets which route the given percentage of traffic to the
+// revision. The remaining traffic is split among the original targets in proportion to their
+// current share. Tagged targets are kept, even when they don't receive traffic anymore.
+// If all traffic is shifted and latest is true, the revision is referenced as the latest revision.
+func ShiftTraffic(targets []servingv1.TrafficTarget, revision string, percent int64, latest bool) ServiceTraffic {
+	var shifted ServiceTraffic
+	remaining := 100 - percent
+	var total, assigned, largestPercent int64
+	largest := -1
+	for _, target := range targets {
+		target := *target.DeepCopy()
+		current := int64(0)
+		if target.Percent != nil {
+			current = *target.Percent
+		}
+		share := current * remaining / 100
+		total += current
+		assigned += share
+		if share == 0 && target.Tag == "" {
+			continue
+		}
+		target.Percent = ptr.Int64(share)
+		shifted = append(shifted, target)
+		if current > largestPercent {
+			largest, largestPercent = len(shifted)-1, current
+		}
+	}
+	if total > 0 && largest >= 0 {
+		// give the rounding difference to the target with the largest share
+		*shifted[largest].Percent += remaining - assigned
+	}
+
+	if percent == 100 && latest {
+		return append(ServiceTraffic{newTarget("", "", percent, true)}, shifted...)
+	}
+	return append(ServiceTraffic{newTarget("", revision, percent, false)}, shifted...)
+}
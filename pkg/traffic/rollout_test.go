@@ -0,0 +1,104 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestPinLatestRevision(t *testing.T) {
+	pinned, err := PinLatestRevision(nil, "echo-v1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pinned, []servingv1.TrafficTarget{newTarget("", "echo-v1", 100, false)})
+
+	targets := []servingv1.TrafficTarget{
+		newTarget("current", "", 80, true),
+		newTarget("old", "echo-v0", 20, false),
+	}
+	pinned, err = PinLatestRevision(targets, "echo-v1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pinned, []servingv1.TrafficTarget{
+		newTarget("current", "echo-v1", 80, false),
+		newTarget("old", "echo-v0", 20, false),
+	})
+	// the original targets are not changed
+	assert.Equal(t, *targets[0].LatestRevision, true)
+
+	_, err = PinLatestRevision(targets, "")
+	assert.ErrorContains(t, err, "no ready revision")
+}
+
+func TestShiftTraffic(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		targets  []servingv1.TrafficTarget
+		percent  int64
+		latest   bool
+		expected ServiceTraffic
+	}{
+		{
+			name:    "single target",
+			targets: []servingv1.TrafficTarget{newTarget("", "echo-v1", 100, false)},
+			percent: 10,
+			expected: ServiceTraffic{
+				newTarget("", "echo-v2", 10, false),
+				newTarget("", "echo-v1", 90, false),
+			},
+		},
+		{
+			name: "split is kept in proportion",
+			targets: []servingv1.TrafficTarget{
+				newTarget("", "echo-v1", 70, false),
+				newTarget("stable", "echo-v0", 30, false),
+			},
+			percent: 25,
+			expected: ServiceTraffic{
+				newTarget("", "echo-v2", 25, false),
+				newTarget("", "echo-v1", 53, false),
+				newTarget("stable", "echo-v0", 22, false),
+			},
+		},
+		{
+			name: "tagged targets are kept",
+			targets: []servingv1.TrafficTarget{
+				newTarget("", "echo-v1", 100, false),
+				newTarget("test", "echo-v0", 0, false),
+			},
+			percent: 100,
+			expected: ServiceTraffic{
+				newTarget("", "echo-v2", 100, false),
+				newTarget("test", "echo-v0", 0, false),
+			},
+		},
+		{
+			name:    "latest revision",
+			targets: []servingv1.TrafficTarget{newTarget("", "echo-v1", 100, false)},
+			percent: 100,
+			latest:  true,
+			expected: ServiceTraffic{
+				{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+			},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			shifted := ShiftTraffic(c.targets, "echo-v2", c.percent, c.latest)
+			assert.DeepEqual(t, shifted, c.expected)
+		})
+	}
+}
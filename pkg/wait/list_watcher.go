@@ -0,0 +1,218 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"sync"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type listF func(context.Context, v1.ListOptions) (runtime.Object, error)
+
+type pollingListWatcher struct {
+	done   chan struct{}
+	result chan watch.Event
+	wg     *sync.WaitGroup
+	once   sync.Once
+	// we can mock the interface for testing.
+	pollInterval PollInterval
+	poll         func() (runtime.Object, error)
+}
+
+// NewListWatcherWithVersion makes a watch.Interface on all resources selected by the
+// given list options, falling back to polling the list if the server does not support
+// Watch. When polling, all resources of the first poll are reported as added.
+func NewListWatcherWithVersion(ctx context.Context, watchFunc watchF, listFunc listF, opts v1.ListOptions) (watch.Interface, error) {
+	watchOpts := opts
+	watchOpts.Watch = true
+	native, err := watchFunc(ctx, watchOpts)
+	if err == nil {
+		return native, nil
+	}
+	listOpts := opts
+	listOpts.ResourceVersion = ""
+	polling := &pollingListWatcher{
+		done:         make(chan struct{}),
+		result:       make(chan watch.Event),
+		wg:           &sync.WaitGroup{},
+		pollInterval: newTickerPollInterval(pollInterval),
+		poll: func() (runtime.Object, error) {
+			return listFunc(ctx, listOpts)
+		},
+	}
+	polling.start()
+	return polling, nil
+}
+
+func (w *pollingListWatcher) start() {
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+		defer w.pollInterval.Stop()
+		var old []runtime.Object
+		for {
+			select {
+			case <-w.pollInterval.PollChan():
+				list, err := w.poll()
+				if err != nil {
+					if !w.send(watch.Event{Type: watch.Error, Object: errorStatus(err)}) {
+						return
+					}
+					continue
+				}
+				items, err := meta.ExtractList(list)
+				if err != nil {
+					if !w.send(watch.Event{Type: watch.Error, Object: errorStatus(err)}) {
+						return
+					}
+					continue
+				}
+				for _, event := range diffLists(old, items) {
+					if !w.send(event) {
+						return
+					}
+				}
+				old = items
+			case <-w.done:
+				return
+			}
+		}
+	}()
+}
+
+// send delivers an event unless the watcher has been stopped
+func (w *pollingListWatcher) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
+func (w *pollingListWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *pollingListWatcher) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+		w.wg.Wait()
+		close(w.result)
+	})
+}
+
+// diffLists returns the events turning the old list of resources into the new one. Resources
+// are identified by their namespace and name and have changed if their resource version
+// differs, so that a resource deleted and created again between two polls is modified.
+func diffLists(old, new []runtime.Object) []watch.Event {
+	versions := make(map[string]string, len(old))
+	for _, obj := range old {
+		if accessor, err := meta.Accessor(obj); err == nil {
+			versions[objectKey(accessor)] = accessor.GetResourceVersion()
+		}
+	}
+	var events []watch.Event
+	current := make(map[string]bool, len(new))
+	for _, obj := range new {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			continue
+		}
+		current[objectKey(accessor)] = true
+		version, found := versions[objectKey(accessor)]
+		switch {
+		case !found:
+			events = append(events, watch.Event{Type: watch.Added, Object: obj})
+		case version != accessor.GetResourceVersion():
+			events = append(events, watch.Event{Type: watch.Modified, Object: obj})
+		}
+	}
+	for _, obj := range old {
+		if accessor, err := meta.Accessor(obj); err == nil && !current[objectKey(accessor)] {
+			events = append(events, watch.Event{Type: watch.Deleted, Object: obj})
+		}
+	}
+	return events
+}
+
+func objectKey(obj v1.Object) string {
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func errorStatus(err error) *v1.Status {
+	if status, ok := err.(api_errors.APIStatus); ok {
+		s := status.Status()
+		return &s
+	}
+	return &api_errors.NewInternalError(err).ErrStatus
+}
+
+type mergedWatcher struct {
+	watchers []watch.Interface
+	result   chan watch.Event
+	done     chan struct{}
+	wg       sync.WaitGroup
+	once     sync.Once
+}
+
+// MergeWatchers combines the events of the given watchers into a single watch.Interface,
+// e.g. for watching resources of different kinds. The merged watcher is closed when all
+// given watchers are closed.
+func MergeWatchers(watchers ...watch.Interface) watch.Interface {
+	w := &mergedWatcher{
+		watchers: watchers,
+		result:   make(chan watch.Event),
+		done:     make(chan struct{}),
+	}
+	w.wg.Add(len(watchers))
+	for _, watcher := range watchers {
+		go func(watcher watch.Interface) {
+			defer w.wg.Done()
+			for event := range watcher.ResultChan() {
+				select {
+				case w.result <- event:
+				case <-w.done:
+					return
+				}
+			}
+		}(watcher)
+	}
+	go func() {
+		w.wg.Wait()
+		close(w.result)
+	}()
+	return w
+}
+
+func (w *mergedWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *mergedWatcher) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+		for _, watcher := range w.watchers {
+			watcher.Stop()
+		}
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestNewListWatcherWithVersion(t *testing.T) {
+	var watchOpts metav1.ListOptions
+	w, err := NewListWatcherWithVersion(context.Background(), func(_ context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+		watchOpts = opts
+		return NewFakeWatch([]watch.Event{}), nil
+	}, nil, metav1.ListOptions{ResourceVersion: "v1"})
+	assert.NilError(t, err)
+	assert.Assert(t, watchOpts.Watch)
+	assert.Equal(t, watchOpts.ResourceVersion, "v1")
+	w.Stop()
+
+	w, err = NewListWatcherWithVersion(context.Background(), func(context.Context, metav1.ListOptions) (watch.Interface, error) {
+		return nil, errors.New("watch not supported")
+	}, func(context.Context, metav1.ListOptions) (runtime.Object, error) {
+		return &servingv1.ServiceList{}, nil
+	}, metav1.ListOptions{ResourceVersion: "v1"})
+	assert.NilError(t, err)
+	_, ok := w.(*pollingListWatcher)
+	assert.Assert(t, ok)
+	w.Stop()
+	w.Stop()
+}
+
+func TestPollListWatcher(t *testing.T) {
+	first := newListService("first", "one", "a")
+	second := newListService("second", "two", "a")
+	polls := []runtime.Object{
+		newServiceList(first),
+		newServiceList(first, second),
+		newServiceList(newListService("first", "one", "b"), second),
+		nil,
+		newServiceList(newListService("first", "one", "b")),
+	}
+	expected := []struct {
+		eventType watch.EventType
+		name      string
+		version   string
+	}{
+		{watch.Added, "first", "a"},
+		{watch.Added, "second", "a"},
+		{watch.Modified, "first", "b"},
+		{watch.Error, "", ""},
+		{watch.Deleted, "second", "a"},
+	}
+
+	i := 0
+	w := &pollingListWatcher{
+		done:         make(chan struct{}),
+		result:       make(chan watch.Event),
+		wg:           &sync.WaitGroup{},
+		pollInterval: newFakePollInterval(len(polls)),
+		poll: func() (runtime.Object, error) {
+			defer func() { i++ }()
+			if polls[i] == nil {
+				return nil, errors.New("connection refused")
+			}
+			return polls[i], nil
+		},
+	}
+	w.start()
+	for _, e := range expected {
+		actual := <-w.ResultChan()
+		assert.Equal(t, actual.Type, e.eventType)
+		if e.eventType == watch.Error {
+			assert.Equal(t, actual.Object.(*metav1.Status).Message, "Internal error occurred: connection refused")
+			continue
+		}
+		assert.Equal(t, actual.Object.(metav1.Object).GetName(), e.name)
+		assert.Equal(t, actual.Object.(metav1.Object).GetResourceVersion(), e.version)
+	}
+	w.Stop()
+}
+
+func newListService(name, uid, version string) servingv1.Service {
+	return servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(uid), ResourceVersion: version}}
+}
+
+func newServiceList(services ...servingv1.Service) *servingv1.ServiceList {
+	return &servingv1.ServiceList{Items: services}
+}
+
+func TestMergeWatchers(t *testing.T) {
+	first := watch.NewFake()
+	second := watch.NewFake()
+	w := MergeWatchers(first, second)
+
+	go first.Add(&servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "first"}})
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Object.(metav1.Object).GetName(), "first")
+	go second.Delete(&servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "second"}})
+	event = <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Deleted)
+	assert.Equal(t, event.Object.(metav1.Object).GetName(), "second")
+
+	w.Stop()
+	_, ok := <-w.ResultChan()
+	assert.Assert(t, !ok)
+	assert.Assert(t, first.IsStopped() && second.IsStopped())
+}